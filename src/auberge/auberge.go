@@ -0,0 +1,109 @@
+// Package auberge gère les auberges des villes et villages
+// Permet de se reposer contre de l'or pour récupérer PV et mana, en faisant avancer l'horloge
+package auberge
+
+import (
+	"fmt"
+	"world_of_milousques/character"
+	"world_of_milousques/ui"
+	"world_of_milousques/utils"
+)
+
+// Auberge représente une auberge et ses tarifs
+type Auberge struct {
+	Nom        string
+	Aubergiste string
+	Accueil    string
+	PrixNuit   int // Repos complet de 8 heures
+	PrixSieste int // Repos partiel de 2 heures
+}
+
+// GetAuberge retourne une auberge à partir de son nom
+func GetAuberge(nom string) (Auberge, bool) {
+	switch nom {
+	case "Auberge du Chacha Ronronnant":
+		return Auberge{
+			Nom:        "Auberge du Chacha Ronronnant",
+			Aubergiste: "Tenancière Berthe",
+			Accueil:    "Un bon lit et une soupe chaude, rien de tel après une journée d'aventure !",
+			PrixNuit:   40,
+			PrixSieste: 15,
+		}, true
+	case "Auberge du Pichon Doré":
+		return Auberge{
+			Nom:        "Auberge du Pichon Doré",
+			Aubergiste: "Aubergiste Yann",
+			Accueil:    "Nos hamacs sentent un peu le poisson, mais on y dort comme un bébé !",
+			PrixNuit:   25,
+			PrixSieste: 10,
+		}, true
+	default:
+		return Auberge{}, false
+	}
+}
+
+// AfficherAuberge affiche le menu de l'auberge
+func AfficherAuberge(joueur *character.Character, nomAuberge string) {
+	auberge, existe := GetAuberge(nomAuberge)
+	if !existe {
+		fmt.Printf("❌ %s est fermée.\n", nomAuberge)
+		return
+	}
+
+	fmt.Printf("\n🛏️  === %s === 🛏️\n", auberge.Nom)
+	fmt.Printf("%s : %s\n", auberge.Aubergiste, auberge.Accueil)
+	fmt.Printf("%s | PV : %d/%d | Mana : %d/%d | 💳 %d pièces d'or\n",
+		joueur.DescriptionTemps(), joueur.Pdv, joueur.PdvMax, joueur.Mana, joueur.ManaMax, joueur.Argent)
+
+	options := []string{
+		fmt.Sprintf("Passer la nuit (8h, PV et mana au maximum) - %d or", auberge.PrixNuit),
+		fmt.Sprintf("Faire une sieste (2h, moitié des PV et du mana) - %d or", auberge.PrixSieste),
+		"Quitter l'auberge",
+	}
+
+	ui.AfficherMenu("Auberge", options)
+	choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+
+	switch choix {
+	case 1:
+		seReposer(joueur, auberge, auberge.PrixNuit, 8, 100)
+	case 2:
+		seReposer(joueur, auberge, auberge.PrixSieste, 2, 50)
+	default:
+		fmt.Printf("%s : Bonne route, aventurier !\n", auberge.Aubergiste)
+	}
+}
+
+// seReposer fait payer le repos et rend un pourcentage des PV et du mana maximum
+func seReposer(joueur *character.Character, auberge Auberge, prix, heures, pourcentage int) {
+	if joueur.Argent < prix {
+		fmt.Printf("💸 %s : Il vous faut %d pièces d'or pour une chambre.\n", auberge.Aubergiste, prix)
+		return
+	}
+
+	joueur.Argent -= prix
+	joueur.AvancerTemps(heures)
+
+	joueur.Pdv += joueur.PdvMax * pourcentage / 100
+	if joueur.Pdv > joueur.PdvMax {
+		joueur.Pdv = joueur.PdvMax
+	}
+	joueur.Mana += joueur.ManaMax * pourcentage / 100
+	if joueur.Mana > joueur.ManaMax {
+		joueur.Mana = joueur.ManaMax
+	}
+	joueur.SoignerEquipe(pourcentage)
+
+	fmt.Printf("💤 Vous vous reposez %dh. Vous vous réveillez : %s\n", heures, joueur.DescriptionTemps())
+	fmt.Printf("❤️  PV : %d/%d | 🔮 Mana : %d/%d\n", joueur.Pdv, joueur.PdvMax, joueur.Mana, joueur.ManaMax)
+	for _, compagnon := range joueur.Compagnons {
+		fmt.Printf("🐾 %s : PV %d/%d\n", compagnon.Nom, compagnon.Pv, compagnon.PvMax)
+	}
+	for _, mercenaire := range joueur.Mercenaires {
+		fmt.Printf("🗡️  %s : PV %d/%d\n", mercenaire.Nom, mercenaire.Pv, mercenaire.PvMax)
+	}
+
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+}
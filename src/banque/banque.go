@@ -98,8 +98,33 @@ func (b *Banque) RetirerObjet(index int) (item.Item, bool) {
 	return objet, true
 }
 
+// Agence représente un guichet de la banque
+// Toutes les agences donnent accès au même coffre-fort
+type Agence struct {
+	Nom      string
+	Banquier string
+}
+
+// GetAgence retourne une agence à partir de son nom
+func GetAgence(nom string) (Agence, bool) {
+	switch nom {
+	case "Banque Royale d'Astrab":
+		return Agence{Nom: "BANQUE ROYALE D'ASTRAB", Banquier: "Banquier Salomon"}, true
+	case "Comptoir de Port-Pichon":
+		return Agence{Nom: "COMPTOIR DE PORT-PICHON", Banquier: "Guichetière Maëlle"}, true
+	default:
+		return Agence{}, false
+	}
+}
+
 // AfficherBanque gère l'interface de la banque
-func AfficherBanque(joueur *character.Character) {
+func AfficherBanque(joueur *character.Character, nomAgence string) {
+	agence, existe := GetAgence(nomAgence)
+	if !existe {
+		fmt.Printf("❌ %s est fermée.\n", nomAgence)
+		return
+	}
+	
 	banque, err := ChargerBanque(joueur.Nom)
 	if err != nil {
 		fmt.Printf("Erreur lors du chargement de votre coffre : %v\n", err)
@@ -107,8 +132,8 @@ func AfficherBanque(joueur *character.Character) {
 	}
 	
 	for {
-		fmt.Println("\n🏦 === BANQUE ROYALE D'ASTRAB === 🏦")
-		fmt.Printf("Banquier Salomon : Bienvenue %s ! Votre coffre-fort vous attend.\n", joueur.Nom)
+		fmt.Printf("\n🏦 === %s === 🏦\n", agence.Nom)
+		fmt.Printf("%s : Bienvenue %s ! Votre coffre-fort vous attend.\n", agence.Banquier, joueur.Nom)
 		fmt.Printf("💰 Capacité du coffre : %d/%d objets\n", len(banque.Objets), banque.MaxCapacite)
 		fmt.Printf("🎒 Votre inventaire : %d/100 objets\n", len(joueur.Inventaire.Items))
 		
@@ -139,7 +164,7 @@ func AfficherBanque(joueur *character.Character) {
 			if err := banque.Sauvegarder(); err != nil {
 				fmt.Printf("Erreur lors de la sauvegarde : %v\n", err)
 			} else {
-				fmt.Println("Banquier Salomon : Vos biens sont en sécurité ! À bientôt !")
+				fmt.Printf("%s : Vos biens sont en sécurité ! À bientôt !\n", agence.Banquier)
 			}
 			return
 		}
@@ -0,0 +1,244 @@
+// Package carte exporte la carte du monde dans un fichier image (PNG ou SVG)
+// Les images sont générées avec la bibliothèque standard pour être partagées facilement
+package carte
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+	"world_of_milousques/world"
+)
+
+// Dimensions de l'image exportée (en pixels)
+const (
+	tailleCase     = 96
+	marge          = 16
+	hauteurLegende = 110
+)
+
+// Couleurs des cases et des marqueurs
+var (
+	couleurFond      = color.RGBA{R: 30, G: 30, B: 36, A: 255}
+	couleurInconnue  = color.RGBA{R: 70, G: 70, B: 78, A: 255}
+	couleurBordure   = color.RGBA{R: 15, G: 15, B: 18, A: 255}
+	couleurJoueur    = color.RGBA{R: 220, G: 30, B: 30, A: 255}
+	couleurQuete     = color.RGBA{R: 255, G: 220, B: 0, A: 255}
+	couleurServices  = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+	couleurEvenement = color.RGBA{R: 160, G: 0, B: 200, A: 255}
+)
+
+// couleurBiome retourne la couleur de fond d'un biome
+func couleurBiome(biome world.Biome) color.RGBA {
+	switch biome {
+	case world.BiomeChamps:
+		return color.RGBA{R: 218, G: 190, B: 80, A: 255}
+	case world.BiomeForet:
+		return color.RGBA{R: 40, G: 120, B: 50, A: 255}
+	case world.BiomeMine:
+		return color.RGBA{R: 120, G: 95, B: 110, A: 255}
+	case world.BiomeRiviere:
+		return color.RGBA{R: 50, G: 130, B: 200, A: 255}
+	case world.BiomeRoute:
+		return color.RGBA{R: 150, G: 110, B: 70, A: 255}
+	case world.BiomeVille:
+		return color.RGBA{R: 200, G: 170, B: 140, A: 255}
+	default:
+		return couleurInconnue
+	}
+}
+
+// Exporter enregistre la carte dans un fichier dont l'extension choisit le format (.png ou .svg)
+func Exporter(m *world.Map, chemin string) error {
+	if dossier := filepath.Dir(chemin); dossier != "." {
+		if err := os.MkdirAll(dossier, 0755); err != nil {
+			return err
+		}
+	}
+
+	switch strings.ToLower(filepath.Ext(chemin)) {
+	case ".png":
+		return ExporterPNG(m, chemin)
+	case ".svg":
+		return ExporterSVG(m, chemin)
+	default:
+		return fmt.Errorf("format non pris en charge : %s (utilisez .png ou .svg)", filepath.Ext(chemin))
+	}
+}
+
+// infosCase regroupe ce qu'il faut dessiner pour une case de la carte
+type infosCase struct {
+	connue    bool
+	joueur    bool
+	quete     bool
+	services  bool
+	evenement bool
+	couleur   color.RGBA
+}
+
+// lireCase extrait les informations d'affichage d'une zone
+// Une zone inconnue ne révèle ni son biome ni ses marqueurs
+func lireCase(m *world.Map, x, y int) infosCase {
+	zone := m.GetZoneAt(x, y)
+	infos := infosCase{
+		joueur:  m.Position.X == x && m.Position.Y == y,
+		couleur: couleurInconnue,
+	}
+	infos.connue = zone.Visitee || infos.joueur
+	if !infos.connue {
+		return infos
+	}
+
+	infos.couleur = couleurBiome(zone.Biome)
+	infos.services = len(zone.Services) > 0
+	infos.evenement = zone.Evenement != ""
+	for _, pnj := range zone.PNJs {
+		if pnj.Quete != "" {
+			infos.quete = true
+			break
+		}
+	}
+	return infos
+}
+
+// ExporterPNG dessine la carte dans une image PNG
+func ExporterPNG(m *world.Map, chemin string) error {
+	largeur := 2*marge + 5*tailleCase
+	img := image.NewRGBA(image.Rect(0, 0, largeur, largeur))
+	remplir(img, img.Bounds(), couleurFond)
+
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			infos := lireCase(m, x, y)
+			x0, y0 := marge+x*tailleCase, marge+y*tailleCase
+			remplir(img, image.Rect(x0, y0, x0+tailleCase, y0+tailleCase), couleurBordure)
+			remplir(img, image.Rect(x0+2, y0+2, x0+tailleCase-2, y0+tailleCase-2), infos.couleur)
+
+			// Marqueurs dans les coins de la case
+			taille := tailleCase / 6
+			if infos.quete {
+				remplir(img, image.Rect(x0+6, y0+6, x0+6+taille, y0+6+taille), couleurQuete)
+			}
+			if infos.services {
+				remplir(img, image.Rect(x0+tailleCase-6-taille, y0+6, x0+tailleCase-6, y0+6+taille), couleurServices)
+			}
+			if infos.evenement {
+				remplir(img, image.Rect(x0+6, y0+tailleCase-6-taille, x0+6+taille, y0+tailleCase-6), couleurEvenement)
+			}
+
+			// Losange rouge au centre pour la position du joueur
+			if infos.joueur {
+				cx, cy, rayon := x0+tailleCase/2, y0+tailleCase/2, tailleCase/4
+				for dy := -rayon; dy <= rayon; dy++ {
+					for dx := -rayon; dx <= rayon; dx++ {
+						if abs(dx)+abs(dy) <= rayon {
+							img.Set(cx+dx, cy+dy, couleurJoueur)
+						}
+					}
+				}
+			}
+		}
+	}
+
+	fichier, err := os.Create(chemin)
+	if err != nil {
+		return err
+	}
+	defer fichier.Close()
+
+	return png.Encode(fichier, img)
+}
+
+// remplir colore un rectangle de l'image
+func remplir(img *image.RGBA, zone image.Rectangle, c color.RGBA) {
+	for y := zone.Min.Y; y < zone.Max.Y; y++ {
+		for x := zone.Min.X; x < zone.Max.X; x++ {
+			img.Set(x, y, c)
+		}
+	}
+}
+
+// abs retourne la valeur absolue d'un entier
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// ExporterSVG dessine la carte dans un fichier SVG, avec le nom des zones et une légende
+func ExporterSVG(m *world.Map, chemin string) error {
+	largeur := 2*marge + 5*tailleCase
+	hauteur := largeur + hauteurLegende
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "<svg xmlns=\"http://www.w3.org/2000/svg\" width=\"%d\" height=\"%d\" font-family=\"sans-serif\">\n", largeur, hauteur)
+	fmt.Fprintf(&b, "  <rect width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", largeur, hauteur, hex(couleurFond))
+
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			infos := lireCase(m, x, y)
+			x0, y0 := marge+x*tailleCase, marge+y*tailleCase
+			fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\" stroke=\"%s\" stroke-width=\"2\"/>\n",
+				x0, y0, tailleCase, tailleCase, hex(infos.couleur), hex(couleurBordure))
+
+			nom := "?"
+			if infos.connue {
+				nom = echapper(m.GetZoneAt(x, y).Nom)
+			}
+			fmt.Fprintf(&b, "  <text x=\"%d\" y=\"%d\" font-size=\"11\" text-anchor=\"middle\" fill=\"#111\">%s</text>\n",
+				x0+tailleCase/2, y0+tailleCase-8, nom)
+
+			taille := tailleCase / 6
+			if infos.quete {
+				fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", x0+6, y0+6, taille, taille, hex(couleurQuete))
+			}
+			if infos.services {
+				fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", x0+tailleCase-6-taille, y0+6, taille, taille, hex(couleurServices))
+			}
+			if infos.evenement {
+				fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"%d\" height=\"%d\" fill=\"%s\"/>\n", x0+6, y0+tailleCase-22-taille, taille, taille, hex(couleurEvenement))
+			}
+			if infos.joueur {
+				cx, cy, rayon := x0+tailleCase/2, y0+tailleCase/2-6, tailleCase/5
+				fmt.Fprintf(&b, "  <polygon points=\"%d,%d %d,%d %d,%d %d,%d\" fill=\"%s\"/>\n",
+					cx, cy-rayon, cx+rayon, cy, cx, cy+rayon, cx-rayon, cy, hex(couleurJoueur))
+			}
+		}
+	}
+
+	// Légende sous la grille
+	legende := []struct {
+		couleur color.RGBA
+		texte   string
+	}{
+		{couleurJoueur, "Vous"}, {couleurQuete, "Quête"}, {couleurServices, "Boutiques"}, {couleurEvenement, "Événement"},
+		{couleurBiome(world.BiomeVille), "Ville"}, {couleurBiome(world.BiomeRoute), "Route"}, {couleurBiome(world.BiomeChamps), "Champs"},
+		{couleurBiome(world.BiomeForet), "Forêt"}, {couleurBiome(world.BiomeMine), "Mine"}, {couleurBiome(world.BiomeRiviere), "Rivière"},
+		{couleurInconnue, "Inconnue"},
+	}
+	for i, l := range legende {
+		lx := marge + (i%4)*(5*tailleCase/4)
+		ly := largeur + 10 + (i/4)*24
+		fmt.Fprintf(&b, "  <rect x=\"%d\" y=\"%d\" width=\"14\" height=\"14\" fill=\"%s\"/>\n", lx, ly, hex(l.couleur))
+		fmt.Fprintf(&b, "  <text x=\"%d\" y=\"%d\" font-size=\"13\" fill=\"#eee\">%s</text>\n", lx+20, ly+12, l.texte)
+	}
+	fmt.Fprintf(&b, "  <text x=\"%d\" y=\"%d\" font-size=\"12\" fill=\"#aaa\">Jour %d, %02dh</text>\n",
+		marge, hauteur-10, m.Temps/24+1, m.Temps%24)
+	b.WriteString("</svg>\n")
+
+	return os.WriteFile(chemin, []byte(b.String()), 0644)
+}
+
+// hex convertit une couleur en notation hexadécimale pour le SVG
+func hex(c color.RGBA) string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
+
+// echapper protège les caractères spéciaux du XML
+func echapper(texte string) string {
+	return strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", "\"", "&quot;").Replace(texte)
+}
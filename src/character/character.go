@@ -26,6 +26,7 @@ type ZoneState struct {
 	Visitee bool `json:"visitee"`
 	RessourcesRestantes []string `json:"ressources_restantes"` // Noms des ressources encore présentes
 	MonstresRestants []MonstreState `json:"monstres_restants"` // Monstres encore vivants
+	PointsDecouverts []string `json:"points_decouverts,omitempty"` // Points d'intérêt cachés déjà trouvés
 }
 
 // MonstreState représente l'état d'un monstre
@@ -33,6 +34,8 @@ type MonstreState struct {
 	Nom string `json:"nom"`
 	Pv int `json:"pv"`
 	Attaque int `json:"attaque"`
+	PvMax int `json:"pv_max,omitempty"` // PV avant d'être blessé (0 = jamais blessé)
+	Temps int `json:"temps,omitempty"` // Heure du jeu à laquelle ces PV ont été notés, pour la régénération
 }
 
 // MapState représente l'état complet de la map
@@ -80,6 +83,67 @@ type Character struct {
 	// Champs simples pour le suivi des zones vidées
 	ZonesRessourcesRecoltees [5][5]bool `json:"zones_ressources_recoltees"`
 	ZonesMonstresVaincus [5][5]bool     `json:"zones_monstres_vaincus"`
+	// Horloge du monde en heures écoulées depuis le début de l'aventure
+	TempsDeJeu int `json:"temps_de_jeu"`
+	// Progression dans les donjons, indexée par nom de donjon
+	Donjons map[string]EtatDonjon `json:"donjons,omitempty"`
+	// Avancement des événements du monde, indexé par nom d'événement
+	Evenements map[string]EtatEvenement `json:"evenements,omitempty"`
+	// Dernier endroit où chaque PNJ a été aperçu, indexé par nom de PNJ
+	PNJsApercus map[string]RencontrePNJ `json:"pnjs_apercus,omitempty"`
+	// Régions secrètes dont le Milousque a été vaincu
+	MilousquesVaincus []string `json:"milousques_vaincus,omitempty"`
+	// Boss déjà vaincus, qui ne laissent leur butin unique qu'une fois
+	BossVaincus []string `json:"boss_vaincus,omitempty"`
+	// Créatures apprivoisées, la première encore debout combat aux côtés du joueur
+	Compagnons []Compagnon `json:"compagnons,omitempty"`
+	// Mercenaires engagés qui suivent le joueur contre un salaire et une part du butin
+	Mercenaires []Mercenaire `json:"mercenaires,omitempty"`
+}
+
+// Mercenaire est un combattant engagé à la guilde, payé chaque jour de jeu
+type Mercenaire struct {
+	Nom             string `json:"nom"`
+	Role            string `json:"role"`
+	Pv              int    `json:"pv"`
+	PvMax           int    `json:"pv_max"`
+	Attaque         int    `json:"attaque"`
+	Vitesse         int    `json:"vitesse"`
+	Salaire         int    `json:"salaire"`          // Or réclamé chaque jour de jeu
+	PartButin       int    `json:"part_butin"`       // Part (en %) de l'or trouvé sur les monstres et dans les coffres
+	ProchainSalaire int    `json:"prochain_salaire"` // Heure du jeu à laquelle tombe le prochain salaire
+}
+
+// Compagnon est une créature apprivoisée qui combat aux côtés du joueur
+type Compagnon struct {
+	Nom        string `json:"nom"`
+	Espece     string `json:"espece"`
+	Niveau     int    `json:"niveau"`
+	Experience int    `json:"experience"`
+	Pv         int    `json:"pv"`
+	PvMax      int    `json:"pv_max"`
+	Attaque    int    `json:"attaque"`
+	Vitesse    int    `json:"vitesse"`
+}
+
+// RencontrePNJ mémorise où et quand un PNJ a été vu pour la dernière fois
+type RencontrePNJ struct {
+	X     int `json:"x"`
+	Y     int `json:"y"`
+	Temps int `json:"temps"` // Heure de jeu de la rencontre
+}
+
+// EtatEvenement représente l'avancement sauvegardé d'un événement du monde
+type EtatEvenement struct {
+	Debut   int            `json:"debut"`             // Heure de jeu à laquelle l'événement a commencé
+	Termine bool           `json:"termine"`           // L'objectif a été rempli
+	Vaincus map[string]int `json:"vaincus,omitempty"` // Envahisseurs vaincus par zone ("x,y")
+}
+
+// EtatDonjon représente la progression sauvegardée dans un donjon
+type EtatDonjon struct {
+	EtageMax        int      `json:"etage_max"`        // Étage le plus profond débloqué (à partir de 1)
+	SallesExplorees [][]bool `json:"salles_explorees"` // Par étage, salles déjà nettoyées
 }
 
 func InitCharacter(nom string, c classe.Classe, niveau int, pdv int, pdvmax int) Character {
@@ -101,6 +165,7 @@ func InitCharacter(nom string, c classe.Classe, niveau int, pdv int, pdvmax int)
 		PositionY:      2, // Position centrale
 		EtatMap:        MapState{}, // État de map vide (sera initialisée plus tard)
 		ZonesDecouvertes: [5][5]bool{}, // Aucune zone découverte au début
+		TempsDeJeu:     8, // L'aventure commence à 8h du matin
 	}
 }
 
@@ -320,6 +385,21 @@ func (c *Character) CalculerDefenseBonus() int {
 	return bonus
 }
 
+// PossedeObjet vérifie si le personnage porte un objet (inventaire ou équipement)
+func (c *Character) PossedeObjet(nom string) bool {
+	for _, objet := range c.Inventaire.Items {
+		if objet.Nom == nom {
+			return true
+		}
+	}
+	for _, equipe := range []*item.Item{c.ArmeEquipee, c.CasqueEquipe, c.TorseEquipe, c.JambiereEquipee} {
+		if equipe != nil && equipe.Nom == nom {
+			return true
+		}
+	}
+	return false
+}
+
 // === SYSTÈME DE QUÊTES AMÉLIORÉ ===
 
 // ProposerEtAjouterQueteAvecPNJ ajoute une quête avec le PNJ donneur
@@ -493,7 +573,7 @@ func (c *Character) InitialiserEtatMap() {
 	mapVide := true
 	for y := 0; y < 5; y++ {
 		for x := 0; x < 5; x++ {
-			if len(c.EtatMap.Zones[y][x].RessourcesRestantes) > 0 || len(c.EtatMap.Zones[y][x].MonstresRestants) > 0 {
+			if len(c.EtatMap.Zones[y][x].RessourcesRestantes) > 0 || len(c.EtatMap.Zones[y][x].MonstresRestants) > 0 || len(c.EtatMap.Zones[y][x].PointsDecouverts) > 0 {
 				mapVide = false
 				break
 			}
@@ -586,10 +666,30 @@ func (c *Character) SauvegarderEtatZoneComplete(x, y int, ressources []string, m
 			Visitee: true,
 			RessourcesRestantes: ressources,
 			MonstresRestants: monstres,
+			PointsDecouverts: c.EtatMap.Zones[y][x].PointsDecouverts,
 		}
 	}
 }
 
+// EstPointDecouvert vérifie si un point d'intérêt caché d'une zone a déjà été trouvé
+func (c *Character) EstPointDecouvert(x, y int, nom string) bool {
+	if x >= 0 && x < 5 && y >= 0 && y < 5 {
+		for _, point := range c.EtatMap.Zones[y][x].PointsDecouverts {
+			if point == nom {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MarquerPointDecouvert enregistre la découverte d'un point d'intérêt caché
+func (c *Character) MarquerPointDecouvert(x, y int, nom string) {
+	if x >= 0 && x < 5 && y >= 0 && y < 5 && !c.EstPointDecouvert(x, y, nom) {
+		c.EtatMap.Zones[y][x].PointsDecouverts = append(c.EtatMap.Zones[y][x].PointsDecouverts, nom)
+	}
+}
+
 // ZoneRessourcesRecoltees vérifie si les ressources d'une zone ont déjà été récoltées
 func (c *Character) ZoneRessourcesRecoltees(x, y int) bool {
 	if x >= 0 && x < 5 && y >= 0 && y < 5 {
@@ -621,6 +721,321 @@ func (c *Character) InitialiserEtatZoneSiNecessaire(x, y int, ressources []strin
 	}
 }
 
+// === HORLOGE DU MONDE ===
+
+// AvancerTemps fait avancer l'horloge du jeu d'un certain nombre d'heures
+func (c *Character) AvancerTemps(heures int) {
+	if heures > 0 {
+		c.TempsDeJeu += heures
+	}
+}
+
+// ObtenirHeure retourne l'heure de la journée (0 à 23)
+func (c *Character) ObtenirHeure() int {
+	return c.TempsDeJeu % 24
+}
+
+// ObtenirJour retourne le numéro du jour en cours (à partir de 1)
+func (c *Character) ObtenirJour() int {
+	return c.TempsDeJeu/24 + 1
+}
+
+// DescriptionTemps retourne la date et l'heure du jeu sous forme lisible
+func (c *Character) DescriptionTemps() string {
+	return fmt.Sprintf("Jour %d, %02dh", c.ObtenirJour(), c.ObtenirHeure())
+}
+
+// === PROGRESSION DANS LES DONJONS ===
+
+// ObtenirEtageMaxDonjon retourne l'étage le plus profond débloqué dans un donjon (1 au minimum)
+func (c *Character) ObtenirEtageMaxDonjon(nomDonjon string) int {
+	if etat, existe := c.Donjons[nomDonjon]; existe && etat.EtageMax > 1 {
+		return etat.EtageMax
+	}
+	return 1
+}
+
+// DebloquerEtageDonjon enregistre qu'un étage d'un donjon est accessible
+func (c *Character) DebloquerEtageDonjon(nomDonjon string, etage int) {
+	if c.Donjons == nil {
+		c.Donjons = map[string]EtatDonjon{}
+	}
+	etat := c.Donjons[nomDonjon]
+	if etage > etat.EtageMax {
+		etat.EtageMax = etage
+	}
+	c.Donjons[nomDonjon] = etat
+}
+
+// EstSalleExploree vérifie si une salle d'un étage de donjon a déjà été nettoyée
+func (c *Character) EstSalleExploree(nomDonjon string, etage, salle int) bool {
+	etat, existe := c.Donjons[nomDonjon]
+	if !existe || etage < 1 || etage > len(etat.SallesExplorees) {
+		return false
+	}
+	salles := etat.SallesExplorees[etage-1]
+	return salle >= 0 && salle < len(salles) && salles[salle]
+}
+
+// MarquerSalleExploree enregistre qu'une salle d'un étage de donjon a été nettoyée
+func (c *Character) MarquerSalleExploree(nomDonjon string, etage, salle, nombreSalles int) {
+	if etage < 1 || salle < 0 || salle >= nombreSalles {
+		return
+	}
+	if c.Donjons == nil {
+		c.Donjons = map[string]EtatDonjon{}
+	}
+	etat := c.Donjons[nomDonjon]
+	for len(etat.SallesExplorees) < etage {
+		etat.SallesExplorees = append(etat.SallesExplorees, []bool{})
+	}
+	if len(etat.SallesExplorees[etage-1]) < nombreSalles {
+		salles := make([]bool, nombreSalles)
+		copy(salles, etat.SallesExplorees[etage-1])
+		etat.SallesExplorees[etage-1] = salles
+	}
+	etat.SallesExplorees[etage-1][salle] = true
+	c.Donjons[nomDonjon] = etat
+}
+
+// === CONDITIONS D'ACCÈS ===
+
+// ObtenirNiveau retourne le niveau du personnage
+func (c *Character) ObtenirNiveau() int {
+	return c.Niveau
+}
+
+// QueteAccomplie vérifie si une quête a été accomplie (rendue ou non)
+func (c *Character) QueteAccomplie(nom string) bool {
+	for _, q := range c.Quetes {
+		if q.Nom == nom && (q.Accomplie || q.Rendue) {
+			return true
+		}
+	}
+	return false
+}
+
+// AVaincuMilousque vérifie si le Milousque d'une région secrète a été vaincu
+func (c *Character) AVaincuMilousque(region string) bool {
+	for _, r := range c.MilousquesVaincus {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// EnregistrerMilousqueVaincu enregistre la victoire contre le Milousque d'une région secrète
+func (c *Character) EnregistrerMilousqueVaincu(region string) {
+	if !c.AVaincuMilousque(region) {
+		c.MilousquesVaincus = append(c.MilousquesVaincus, region)
+	}
+}
+
+// === BOSS ===
+
+// AVaincuBoss vérifie si un boss a déjà été vaincu
+func (c *Character) AVaincuBoss(nom string) bool {
+	for _, b := range c.BossVaincus {
+		if b == nom {
+			return true
+		}
+	}
+	return false
+}
+
+// EnregistrerBossVaincu enregistre la première victoire contre un boss
+func (c *Character) EnregistrerBossVaincu(nom string) {
+	if !c.AVaincuBoss(nom) {
+		c.BossVaincus = append(c.BossVaincus, nom)
+	}
+}
+
+// === COMPAGNONS ===
+
+// AjouterCompagnon accueille une créature apprivoisée dans l'équipe du joueur
+func (c *Character) AjouterCompagnon(compagnon Compagnon) {
+	c.Compagnons = append(c.Compagnons, compagnon)
+}
+
+// CompagnonActif retourne le premier compagnon encore debout (nil si aucun ne peut combattre)
+func (c *Character) CompagnonActif() *Compagnon {
+	for i := range c.Compagnons {
+		if c.Compagnons[i].Pv > 0 {
+			return &c.Compagnons[i]
+		}
+	}
+	return nil
+}
+
+// ChoisirCompagnonActif place un compagnon en tête de l'équipe pour qu'il combatte en premier
+func (c *Character) ChoisirCompagnonActif(index int) {
+	if index <= 0 || index >= len(c.Compagnons) {
+		return
+	}
+	choisi := c.Compagnons[index]
+	copy(c.Compagnons[1:index+1], c.Compagnons[:index])
+	c.Compagnons[0] = choisi
+}
+
+// SoignerEquipe rend à chaque compagnon et mercenaire un pourcentage de ses PV max
+func (c *Character) SoignerEquipe(pourcentage int) {
+	for i := range c.Compagnons {
+		compagnon := &c.Compagnons[i]
+		compagnon.Pv += compagnon.PvMax * pourcentage / 100
+		if compagnon.Pv > compagnon.PvMax {
+			compagnon.Pv = compagnon.PvMax
+		}
+	}
+	for i := range c.Mercenaires {
+		mercenaire := &c.Mercenaires[i]
+		mercenaire.Pv += mercenaire.PvMax * pourcentage / 100
+		if mercenaire.Pv > mercenaire.PvMax {
+			mercenaire.Pv = mercenaire.PvMax
+		}
+	}
+}
+
+// GagnerExperience fait progresser le compagnon, qui gagne PV et attaque à chaque niveau
+func (compagnon *Compagnon) GagnerExperience(xp int) {
+	compagnon.Experience += xp
+	fmt.Printf("🐾 %s gagne %d points d'expérience.\n", compagnon.Nom, xp)
+	
+	for compagnon.Experience >= compagnon.Niveau*100 {
+		compagnon.Experience -= compagnon.Niveau * 100
+		compagnon.Niveau++
+		compagnon.PvMax += compagnon.PvMax / 10
+		compagnon.Attaque += 3
+		compagnon.Pv = compagnon.PvMax
+		fmt.Printf("🎉 %s passe niveau %d ! (PV %d, Attaque %d)\n", compagnon.Nom, compagnon.Niveau, compagnon.PvMax, compagnon.Attaque)
+	}
+}
+
+// === MERCENAIRES ===
+
+// EmbaucherMercenaire engage un mercenaire, dont le premier salaire tombe dans 24 heures
+func (c *Character) EmbaucherMercenaire(mercenaire Mercenaire) {
+	mercenaire.ProchainSalaire = c.TempsDeJeu + 24
+	c.Mercenaires = append(c.Mercenaires, mercenaire)
+}
+
+// CongedierMercenaire renvoie un mercenaire de l'équipe
+func (c *Character) CongedierMercenaire(index int) {
+	if index >= 0 && index < len(c.Mercenaires) {
+		c.Mercenaires = append(c.Mercenaires[:index], c.Mercenaires[index+1:]...)
+	}
+}
+
+// PayerMercenaires verse les salaires échus depuis le dernier passage de l'horloge
+// Un mercenaire que le joueur ne peut plus payer quitte l'équipe
+func (c *Character) PayerMercenaires() {
+	restants := []Mercenaire{}
+	for _, mercenaire := range c.Mercenaires {
+		paye := true
+		for c.TempsDeJeu >= mercenaire.ProchainSalaire {
+			if c.Argent < mercenaire.Salaire {
+				paye = false
+				break
+			}
+			c.Argent -= mercenaire.Salaire
+			mercenaire.ProchainSalaire += 24
+			fmt.Printf("💸 Vous versez %d pièces d'or de salaire à %s.\n", mercenaire.Salaire, mercenaire.Nom)
+		}
+		if !paye {
+			fmt.Printf("😠 Faute de salaire, %s quitte votre équipe !\n", mercenaire.Nom)
+			continue
+		}
+		restants = append(restants, mercenaire)
+	}
+	c.Mercenaires = restants
+}
+
+// RetenirPartMercenaires prélève sur un butin la part de chaque mercenaire encore debout
+// Retourne l'or qui revient au joueur
+func (c *Character) RetenirPartMercenaires(or int) int {
+	reste := or
+	for _, mercenaire := range c.Mercenaires {
+		part := or * mercenaire.PartButin / 100
+		if mercenaire.Pv <= 0 || part <= 0 {
+			continue
+		}
+		reste -= part
+		fmt.Printf("🤝 %s prend sa part du butin : %d pièces d'or.\n", mercenaire.Nom, part)
+	}
+	return reste
+}
+
+// === PNJS APERÇUS ===
+
+// NoterPNJApercu enregistre qu'un PNJ se trouve dans une zone à l'heure actuelle
+func (c *Character) NoterPNJApercu(nom string, x, y int) {
+	if c.PNJsApercus == nil {
+		c.PNJsApercus = map[string]RencontrePNJ{}
+	}
+	c.PNJsApercus[nom] = RencontrePNJ{X: x, Y: y, Temps: c.TempsDeJeu}
+}
+
+// DernierApercuPNJ retourne le dernier endroit où un PNJ a été vu
+func (c *Character) DernierApercuPNJ(nom string) (RencontrePNJ, bool) {
+	rencontre, existe := c.PNJsApercus[nom]
+	return rencontre, existe
+}
+
+// === ÉVÉNEMENTS DU MONDE ===
+
+// ObtenirEtatEvenement retourne l'état d'un événement s'il a déjà commencé
+func (c *Character) ObtenirEtatEvenement(nom string) (EtatEvenement, bool) {
+	etat, existe := c.Evenements[nom]
+	return etat, existe
+}
+
+// DemarrerEvenement enregistre le début d'un événement (sans effet s'il a déjà commencé)
+func (c *Character) DemarrerEvenement(nom string, temps int) {
+	if c.Evenements == nil {
+		c.Evenements = map[string]EtatEvenement{}
+	}
+	if _, existe := c.Evenements[nom]; !existe {
+		c.Evenements[nom] = EtatEvenement{Debut: temps}
+	}
+}
+
+// EnregistrerVictoireEvenement compte un envahisseur vaincu dans une zone
+// Retourne le nombre total d'envahisseurs vaincus pour cet événement
+func (c *Character) EnregistrerVictoireEvenement(nom string, x, y int) int {
+	etat, existe := c.Evenements[nom]
+	if !existe {
+		return 0
+	}
+	if etat.Vaincus == nil {
+		etat.Vaincus = map[string]int{}
+	}
+	etat.Vaincus[fmt.Sprintf("%d,%d", x, y)]++
+	c.Evenements[nom] = etat
+	return etat.TotalVaincus()
+}
+
+// TerminerEvenement marque un événement comme terminé
+func (c *Character) TerminerEvenement(nom string) {
+	if etat, existe := c.Evenements[nom]; existe {
+		etat.Termine = true
+		c.Evenements[nom] = etat
+	}
+}
+
+// TotalVaincus retourne le nombre d'envahisseurs vaincus dans toutes les zones
+func (e EtatEvenement) TotalVaincus() int {
+	total := 0
+	for _, n := range e.Vaincus {
+		total += n
+	}
+	return total
+}
+
+// VaincusDansZone retourne le nombre d'envahisseurs vaincus dans une zone
+func (e EtatEvenement) VaincusDansZone(x, y int) int {
+	return e.Vaincus[fmt.Sprintf("%d,%d", x, y)]
+}
+
 // === UTILISATION DE POTIONS ===
 
 // UtiliserPotion utilise une potion de vie hors combat
@@ -5,19 +5,33 @@ import (
 )
 
 type Classe struct {
-	Nom     string        `json:"nom"`
-	Pvmax   int           `json:"pv_max"`
-	ManaMax int           `json:"mana_max"`
-	Sorts   []sorts.Sorts `json:"sorts"`
+	Nom      string        `json:"nom"`
+	Pvmax    int           `json:"pv_max"`
+	ManaMax  int           `json:"mana_max"`
+	Sorts    []sorts.Sorts `json:"sorts"`
+	Agilite  int           `json:"agilite"`  // Initiative, précision et esquive en combat
+	Critique int           `json:"critique"` // Chance de coup critique (en %)
+}
+
+// Statistiques retourne l'agilité et la chance de coup critique de la classe
+// Les anciennes sauvegardes n'ont pas ces statistiques : on les retrouve à partir du nom
+func (c Classe) Statistiques() (int, int) {
+	if c.Agilite == 0 && c.Critique == 0 {
+		reference := GetClasse(c.Nom)
+		return reference.Agilite, reference.Critique
+	}
+	return c.Agilite, c.Critique
 }
 
 func GetClasse(nom string) Classe {
 	switch nom {
 	case "Guerrier":
 		return Classe{
-			Nom:     "Guerrier",
-			Pvmax:   130,
-			ManaMax: 70,
+			Nom:      "Guerrier",
+			Pvmax:    130,
+			ManaMax:  70,
+			Agilite:  8,
+			Critique: 10,
 			Sorts: []sorts.Sorts{
 				sorts.GetSorts("Fracasser"),
 				sorts.GetSorts("Briser"),
@@ -25,9 +39,11 @@ func GetClasse(nom string) Classe {
 		}
 	case "Mage":
 		return Classe{
-			Nom:     "Mage",
-			Pvmax:   70,
-			ManaMax: 130,
+			Nom:      "Mage",
+			Pvmax:    70,
+			ManaMax:  130,
+			Agilite:  10,
+			Critique: 8,
 			Sorts: []sorts.Sorts{
 				sorts.GetSorts("Boule de feu"),
 				sorts.GetSorts("Explosion"),
@@ -35,9 +51,11 @@ func GetClasse(nom string) Classe {
 		}
 	case "Voleur":
 		return Classe{
-			Nom:     "Voleur",
-			Pvmax:   100,
-			ManaMax: 100,
+			Nom:      "Voleur",
+			Pvmax:    100,
+			ManaMax:  100,
+			Agilite:  18,
+			Critique: 25,
 			Sorts: []sorts.Sorts{
 				sorts.GetSorts("Coup bas"),
 				sorts.GetSorts("Fourberie"),
@@ -45,10 +63,12 @@ func GetClasse(nom string) Classe {
 		}
 	default:
 	return Classe{
-			Nom:     nom,
-			Pvmax:   100,
-			ManaMax: 100,
-			Sorts:   []sorts.Sorts{},
+			Nom:      nom,
+			Pvmax:    100,
+			ManaMax:  100,
+			Agilite:  10,
+			Critique: 5,
+			Sorts:    []sorts.Sorts{},
 		}
 	}
 }
@@ -4,6 +4,7 @@ package commerce
 
 import (
 	"fmt"
+	"math/rand"
 	"world_of_milousques/character"
 	"world_of_milousques/item"
 	"world_of_milousques/ui"
@@ -42,13 +43,90 @@ func GetMarchandAstrab() Marchand {
 			// Potions (stock illimité)
 			{Item: item.NewItem("Potion de Vie"), Prix: 50, Stock: 0, Illimite: true},
 			{Item: item.NewItem("Potion de Mana"), Prix: 50, Stock: 0, Illimite: true},
+			// Consommables de combat
+			{Item: item.NewItem("Bombe incendiaire"), Prix: 40, Stock: 5, Illimite: false},
+			{Item: item.NewItem("Élixir de pierre"), Prix: 60, Stock: 3, Illimite: false},
+			{Item: item.NewItem("Baume de régénération"), Prix: 45, Stock: 3, Illimite: false},
+			// Apprivoisement des Milousques
+			{Item: item.NewItem("Collier d'apprivoisement"), Prix: 300, Stock: 2, Illimite: false},
 		},
 	}
 }
 
+// GetMarchandPortPichon retourne la marchande du village de Port-Pichon
+func GetMarchandPortPichon() Marchand {
+	return Marchand{
+		Nom:   "Marchande Perle",
+		Salut: "Ohé aventurier ! Filets, barques et potions, tout ce qu'il faut pour la rivière !",
+		Articles: []Article{
+			// Navigation
+			{Item: item.NewItem("Barque"), Prix: 400, Stock: 1, Illimite: false},
+			// Équipement léger
+			{Item: item.NewItem("Casque en Cuir"), Prix: 170, Stock: 2, Illimite: false},
+			{Item: item.NewItem("Dague Simple"), Prix: 280, Stock: 1, Illimite: false},
+			// Potions (stock illimité)
+			{Item: item.NewItem("Potion de Vie"), Prix: 45, Stock: 0, Illimite: true},
+			{Item: item.NewItem("Potion de Mana"), Prix: 60, Stock: 0, Illimite: true},
+			// Consommables de combat
+			{Item: item.NewItem("Fiole de venin"), Prix: 35, Stock: 4, Illimite: false},
+		},
+	}
+}
+
+// GetMarchandAmbulant retourne le colporteur croisé sur les routes
+// Son stock est tiré au hasard à chaque rencontre
+func GetMarchandAmbulant() Marchand {
+	catalogue := []Article{
+		{Item: item.NewItem("Casque en Cuir"), Prix: 130},
+		{Item: item.NewItem("Torse en Cuir"), Prix: 130},
+		{Item: item.NewItem("Jambières en Cuir"), Prix: 130},
+		{Item: item.NewItem("Bâton Simple"), Prix: 220},
+		{Item: item.NewItem("Épée Simple"), Prix: 220},
+		{Item: item.NewItem("Dague Simple"), Prix: 220},
+		{Item: item.NewItem("Lanterne"), Prix: 180},
+		{Item: item.NewItem("Potion de Vie"), Prix: 65},
+		{Item: item.NewItem("Potion de Mana"), Prix: 65},
+		{Item: item.NewItem("Bombe incendiaire"), Prix: 50},
+		{Item: item.NewItem("Fiole de venin"), Prix: 45},
+	}
+	
+	// Garder 3 à 5 articles au hasard, en petite quantité
+	rand.Shuffle(len(catalogue), func(i, j int) {
+		catalogue[i], catalogue[j] = catalogue[j], catalogue[i]
+	})
+	articles := catalogue[:3+rand.Intn(3)]
+	for i := range articles {
+		articles[i].Stock = 1 + rand.Intn(3)
+	}
+	
+	return Marchand{
+		Nom:      "Colporteur Ambroise",
+		Salut:    "Approchez, approchez ! Des merveilles de tout le royaume, mais pas pour longtemps !",
+		Articles: articles,
+	}
+}
+
+// GetMarchand retourne un marchand à partir de son nom
+func GetMarchand(nom string) (Marchand, bool) {
+	switch nom {
+	case "Maître Karim le Marchand":
+		return GetMarchandAstrab(), true
+	case "Marchande Perle":
+		return GetMarchandPortPichon(), true
+	case "Colporteur Ambroise":
+		return GetMarchandAmbulant(), true
+	default:
+		return Marchand{}, false
+	}
+}
+
 // AfficherMarchand affiche le menu principal du marchand
-func AfficherMarchand(joueur *character.Character) {
-	marchand := GetMarchandAstrab()
+func AfficherMarchand(joueur *character.Character, nomMarchand string) {
+	marchand, existe := GetMarchand(nomMarchand)
+	if !existe {
+		fmt.Printf("❌ La boutique de %s est fermée.\n", nomMarchand)
+		return
+	}
 	
 	for {
 		fmt.Printf("\n💰 === %s === 💰\n", marchand.Nom)
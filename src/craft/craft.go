@@ -108,6 +108,27 @@ func GetRecettesDisponibles() []Recette {
 			Produit:         item.NewItem("Dague d'Expert"),
 			QuantiteProduit: 1,
 		},
+		// === OUTILS D'EXPLORATION ===
+		{
+			Nom:         "Barque",
+			Description: "Embarcation pour traverser les eaux profondes",
+			Ingredients: []Ingredient{
+				{Item: item.NewItem("Bois"), Quantite: 15},
+				{Item: item.NewItem("Fer"), Quantite: 5},
+			},
+			Produit:         item.NewItem("Barque"),
+			QuantiteProduit: 1,
+		},
+		{
+			Nom:         "Lanterne",
+			Description: "Lanterne pour s'aventurer au fond des mines",
+			Ingredients: []Ingredient{
+				{Item: item.NewItem("Fer"), Quantite: 5},
+				{Item: item.NewItem("Bois"), Quantite: 3},
+			},
+			Produit:         item.NewItem("Lanterne"),
+			QuantiteProduit: 1,
+		},
 		// === POTIONS ===
 		{
 			Nom:         "Potion de Vie",
@@ -132,11 +153,62 @@ func GetRecettesDisponibles() []Recette {
 	}
 }
 
+// Forge représente un atelier de craft avec ses propres recettes
+type Forge struct {
+	Nom      string
+	Forgeron string
+	Accueil  string
+	Recettes []Recette
+}
+
+// GetForge retourne une forge à partir de son nom
+func GetForge(nom string) (Forge, bool) {
+	switch nom {
+	case "Grande Forge d'Astrab":
+		return Forge{
+			Nom:      "FORGE D'ASTRAB",
+			Forgeron: "Maître Forgeron",
+			Accueil:  "Bienvenue dans ma forge ! Que puis-je créer pour vous ?",
+			Recettes: GetRecettesDisponibles(),
+		}, true
+	case "Atelier du Port":
+		// Un petit atelier de charpentier : outils et potions seulement
+		return Forge{
+			Nom:      "ATELIER DU PORT",
+			Forgeron: "Charpentière Ondine",
+			Accueil:  "Je ne travaille pas le métal fin, mais pour une barque vous êtes au bon endroit !",
+			Recettes: filtrerRecettes("Barque", "Lanterne", "Potion de Vie", "Potion de Mana"),
+		}, true
+	default:
+		return Forge{}, false
+	}
+}
+
+// filtrerRecettes retourne les recettes disponibles correspondant aux noms donnés
+func filtrerRecettes(noms ...string) []Recette {
+	recettes := []Recette{}
+	for _, recette := range GetRecettesDisponibles() {
+		for _, nom := range noms {
+			if recette.Nom == nom {
+				recettes = append(recettes, recette)
+				break
+			}
+		}
+	}
+	return recettes
+}
+
 // AfficherForge affiche le menu principal de la forge
-func AfficherForge(joueur *character.Character) {
+func AfficherForge(joueur *character.Character, nomForge string) {
+	forge, existe := GetForge(nomForge)
+	if !existe {
+		fmt.Printf("❌ %s est fermée.\n", nomForge)
+		return
+	}
+	
 	for {
-		fmt.Println("\n🔨 === FORGE D'ASTRAB === 🔨")
-		fmt.Println("Maître Forgeron : Bienvenue dans ma forge ! Que puis-je créer pour vous ?")
+		fmt.Printf("\n🔨 === %s === 🔨\n", forge.Nom)
+		fmt.Printf("%s : %s\n", forge.Forgeron, forge.Accueil)
 		
 		options := []string{
 			"Voir les recettes disponibles",
@@ -150,24 +222,22 @@ func AfficherForge(joueur *character.Character) {
 		
 		switch choix {
 		case 1:
-			afficherRecettes()
+			afficherRecettes(forge.Recettes)
 		case 2:
-			crafterObjet(joueur)
+			crafterObjet(joueur, forge.Recettes)
 		case 3:
 			joueur.Inventaire.Afficher()
 			fmt.Println("\nAppuyez sur Entrée pour continuer...")
 			fmt.Scanln()
 		case 4:
-			fmt.Println("Maître Forgeron : Revenez quand vous voulez !")
+			fmt.Printf("%s : Revenez quand vous voulez !\n", forge.Forgeron)
 			return
 		}
 	}
 }
 
-// afficherRecettes affiche toutes les recettes disponibles
-func afficherRecettes() {
-	recettes := GetRecettesDisponibles()
-	
+// afficherRecettes affiche toutes les recettes de la forge
+func afficherRecettes(recettes []Recette) {
 	fmt.Println("\n📜 === RECETTES DISPONIBLES === 📜")
 	for i, recette := range recettes {
 		fmt.Printf("\n%d. %s\n", i+1, recette.Nom)
@@ -184,9 +254,7 @@ func afficherRecettes() {
 }
 
 // crafterObjet permet au joueur de crafter un objet
-func crafterObjet(joueur *character.Character) {
-	recettes := GetRecettesDisponibles()
-	
+func crafterObjet(joueur *character.Character, recettes []Recette) {
 	fmt.Println("\n⚒️  === CRÉATION D'OBJET === ⚒️")
 	
 	// Créer les options du menu avec les recettes
@@ -0,0 +1,325 @@
+// Package donjon gère les donjons à plusieurs étages situés sous certaines zones
+// Chaque étage est une suite de salles générées (monstres, pièges, coffres) avec un boss au dernier étage
+package donjon
+
+import (
+	"fmt"
+	"math/rand"
+	"world_of_milousques/character"
+	"world_of_milousques/fight"
+	"world_of_milousques/item"
+	"world_of_milousques/ui"
+	"world_of_milousques/utils"
+)
+
+// TypeSalle définit le contenu d'une salle de donjon
+type TypeSalle string
+
+const (
+	SalleVide    TypeSalle = "vide"
+	SalleMonstre TypeSalle = "monstre"
+	SallePiege   TypeSalle = "piege"
+	SalleCoffre  TypeSalle = "coffre"
+	SalleBoss    TypeSalle = "boss"
+)
+
+// chanceDesamorcerVoleur est la probabilité (en %) qu'un Voleur désamorce un piège
+const chanceDesamorcerVoleur = 50
+
+// Salle représente une salle d'un étage de donjon
+type Salle struct {
+	Type    TypeSalle
+	Monstre fight.Ennemi // Monstre ou boss de la salle
+	Degats  int          // Dégâts infligés par un piège
+	Or      int          // Or contenu dans un coffre
+	Objet   string       // Objet contenu dans un coffre ou laissé par le boss ("" = aucun)
+}
+
+// Etage représente un niveau du donjon
+type Etage struct {
+	Salles []Salle
+}
+
+// Donjon représente un donjon instancié sous une zone de la map
+type Donjon struct {
+	Nom         string
+	Description string
+	Etages      []Etage
+}
+
+// GetDonjon retourne un donjon à partir de son nom
+// Les étages sont générés à partir d'une graine fixe pour rester identiques d'une partie à l'autre
+func GetDonjon(nom string) *Donjon {
+	switch nom {
+	case "Profondeurs Kairis":
+		return genererDonjon(
+			"Profondeurs Kairis",
+			"Un puits s'enfonce sous la mine profonde. Des cliquetis de Kairis résonnent tout en bas.",
+			1042, 3,
+			[]fight.Ennemi{
+				{Nom: "Kairis", Pv: 110, Attaque: 35},
+				{Nom: "Kairis Mineur", Pv: 90, Attaque: 30},
+				{Nom: "Kairis Foreur", Pv: 140, Attaque: 40},
+			},
+			fight.Ennemi{Nom: "Reine Kairis", Pv: 450, Attaque: 50},
+			"Cœur de Kairis",
+		)
+	case "Galeries effondrées":
+		return genererDonjon(
+			"Galeries effondrées",
+			"D'anciennes galeries de mineurs, à moitié effondrées, où les Kairis ont établi un avant-poste.",
+			2077, 2,
+			[]fight.Ennemi{
+				{Nom: "Kairis", Pv: 110, Attaque: 35},
+				{Nom: "Kairis Mineur", Pv: 90, Attaque: 30},
+			},
+			fight.Ennemi{Nom: "Contremaître Kairis", Pv: 300, Attaque: 42},
+			"Pioche du Contremaître",
+		)
+	default:
+		return nil
+	}
+}
+
+// genererDonjon génère les étages d'un donjon
+// Les monstres et pièges deviennent plus dangereux à chaque étage, le boss garde le dernier
+func genererDonjon(nom, description string, graine int64, nbEtages int, monstres []fight.Ennemi, boss fight.Ennemi, butinBoss string) *Donjon {
+	generateur := rand.New(rand.NewSource(graine))
+	d := &Donjon{Nom: nom, Description: description}
+
+	for numero := 1; numero <= nbEtages; numero++ {
+		// +25% de PV et d'attaque par étage
+		multiplicateur := 100 + 25*(numero-1)
+		nbSalles := 4 + generateur.Intn(3) // 4 à 6 salles
+		etage := Etage{}
+
+		for i := 0; i < nbSalles; i++ {
+			salle := Salle{Type: SalleVide}
+			tirage := generateur.Intn(100)
+
+			switch {
+			case i == 0 || tirage < 45: // La première salle est toujours gardée
+				base := monstres[generateur.Intn(len(monstres))]
+				salle.Type = SalleMonstre
+				salle.Monstre = fight.Ennemi{
+					Nom:     base.Nom,
+					Pv:      base.Pv * multiplicateur / 100,
+					Attaque: base.Attaque * multiplicateur / 100,
+				}
+			case tirage < 65:
+				salle.Type = SallePiege
+				salle.Degats = (10 + generateur.Intn(11)) * multiplicateur / 100
+			case tirage < 85:
+				salle.Type = SalleCoffre
+				salle.Or = (20 + generateur.Intn(41)) * numero
+				if generateur.Intn(2) == 0 {
+					salle.Objet = "Potion de Vie"
+				}
+			}
+
+			etage.Salles = append(etage.Salles, salle)
+		}
+
+		// Le boss attend dans la dernière salle du dernier étage
+		if numero == nbEtages {
+			etage.Salles = append(etage.Salles, Salle{Type: SalleBoss, Monstre: boss, Or: 200, Objet: butinBoss})
+		}
+
+		d.Etages = append(d.Etages, etage)
+	}
+
+	return d
+}
+
+// Explorer lance l'exploration du donjon jusqu'au retour à la surface ou la mort du joueur
+func Explorer(joueur *character.Character, d *Donjon) {
+	fmt.Printf("\n🕳️  === %s === 🕳️\n", d.Nom)
+	fmt.Println(d.Description)
+
+	etage := choisirEtage(joueur, d)
+	for etage > 0 && joueur.Pdv > 0 {
+		etage = explorerEtage(joueur, d, etage)
+	}
+
+	if joueur.Pdv > 0 {
+		fmt.Println("\n☀️  Vous remontez à la surface.")
+	}
+}
+
+// choisirEtage propose de reprendre à l'un des étages déjà débloqués
+// Retourne 0 si le joueur préfère rester à la surface
+func choisirEtage(joueur *character.Character, d *Donjon) int {
+	etageMax := joueur.ObtenirEtageMaxDonjon(d.Nom)
+	if etageMax > len(d.Etages) {
+		etageMax = len(d.Etages)
+	}
+
+	options := []string{}
+	for numero := 1; numero <= etageMax; numero++ {
+		options = append(options, fmt.Sprintf("Descendre à l'étage %d/%d (%d/%d salles nettoyées)",
+			numero, len(d.Etages), compterSallesExplorees(joueur, d, numero), len(d.Etages[numero-1].Salles)))
+	}
+	options = append(options, "Rester à la surface")
+
+	ui.AfficherMenu(d.Nom, options)
+	choix := utils.ScanChoice("Où voulez-vous aller ? ", options)
+
+	if choix == len(options) {
+		return 0
+	}
+	return choix
+}
+
+// compterSallesExplorees compte les salles déjà nettoyées d'un étage
+func compterSallesExplorees(joueur *character.Character, d *Donjon, etage int) int {
+	compte := 0
+	for i := range d.Etages[etage-1].Salles {
+		if joueur.EstSalleExploree(d.Nom, etage, i) {
+			compte++
+		}
+	}
+	return compte
+}
+
+// explorerEtage fait progresser le joueur salle par salle dans un étage
+// Retourne l'étage suivant à explorer, ou 0 pour remonter à la surface
+func explorerEtage(joueur *character.Character, d *Donjon, etage int) int {
+	salles := d.Etages[etage-1].Salles
+
+	for joueur.Pdv > 0 {
+		// Reprendre à la première salle qui n'a pas encore été nettoyée
+		index := -1
+		for i := range salles {
+			if !joueur.EstSalleExploree(d.Nom, etage, i) {
+				index = i
+				break
+			}
+		}
+
+		if index == -1 {
+			return terminerEtage(joueur, d, etage)
+		}
+
+		fmt.Printf("\n🔦 Étage %d/%d - Salle %d/%d | PV : %d/%d | Mana : %d/%d\n",
+			etage, len(d.Etages), index+1, len(salles), joueur.Pdv, joueur.PdvMax, joueur.Mana, joueur.ManaMax)
+
+		options := []string{"Avancer dans la salle suivante"}
+		if joueur.Inventaire.Potions > 0 {
+			options = append(options, fmt.Sprintf("Boire une potion de vie (%d disponibles)", joueur.Inventaire.Potions))
+		}
+		options = append(options, "Remonter à la surface")
+
+		ui.AfficherMenu(fmt.Sprintf("%s - Étage %d", d.Nom, etage), options)
+		choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+
+		if choix == len(options) {
+			return 0
+		}
+		if choix == 2 {
+			joueur.UtiliserPotion()
+			continue
+		}
+
+		joueur.AvancerTemps(1)
+		if resoudreSalle(joueur, salles[index]) {
+			joueur.MarquerSalleExploree(d.Nom, etage, index, len(salles))
+		}
+
+		if joueur.Pdv <= 0 {
+			return 0
+		}
+
+		// Sauvegarder la progression de l'étage après chaque salle
+		if err := joueur.Sauvegarder(); err != nil {
+			fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+		}
+	}
+
+	return 0
+}
+
+// terminerEtage débloque l'étage suivant ou annonce la victoire sur le donjon
+// Retourne l'étage suivant à explorer, ou 0 pour remonter à la surface
+func terminerEtage(joueur *character.Character, d *Donjon, etage int) int {
+	if etage == len(d.Etages) {
+		fmt.Printf("\n🏆 Vous avez entièrement nettoyé %s !\n", d.Nom)
+		fmt.Println("\nAppuyez sur Entrée pour continuer...")
+		fmt.Scanln()
+		return 0
+	}
+
+	joueur.DebloquerEtageDonjon(d.Nom, etage+1)
+	fmt.Printf("\n✅ L'étage %d est nettoyé ! Un escalier descend vers l'étage %d.\n", etage, etage+1)
+
+	options := []string{fmt.Sprintf("Descendre à l'étage %d", etage+1), "Remonter à la surface"}
+	ui.AfficherMenu("Escalier", options)
+	choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+
+	if choix == 1 {
+		return etage + 1
+	}
+	return 0
+}
+
+// resoudreSalle applique le contenu d'une salle au joueur
+// Retourne true si la salle est nettoyée (false si le joueur a fui ou est mort)
+func resoudreSalle(joueur *character.Character, salle Salle) bool {
+	switch salle.Type {
+	case SalleMonstre, SalleBoss:
+		monstre := salle.Monstre
+		if salle.Type == SalleBoss {
+			fmt.Printf("\n👑 %s vous attend au fond de la salle !\n", monstre.Nom)
+		} else {
+			fmt.Printf("\n👹 Un %s surgit de l'obscurité !\n", monstre.Nom)
+		}
+		fight.Fight(joueur, &monstre)
+		if monstre.Pv > 0 {
+			return false
+		}
+		if salle.Type == SalleBoss {
+			ouvrirCoffre(joueur, salle)
+		}
+		return true
+
+	case SallePiege:
+		if joueur.Classe.Nom == "Voleur" && rand.Intn(100) < chanceDesamorcerVoleur {
+			fmt.Println("\n🥷 Vous repérez une dalle piégée et la désamorcez habilement.")
+			return true
+		}
+		joueur.Pdv -= salle.Degats
+		fmt.Printf("\n🪤 Un piège se déclenche ! Vous perdez %d PV.\n", salle.Degats)
+		if joueur.Pdv <= 0 {
+			fmt.Println("💀 Vous succombez à vos blessures dans les profondeurs...")
+		}
+		return true
+
+	case SalleCoffre:
+		fmt.Println("\n📦 Vous trouvez un coffre poussiéreux !")
+		ouvrirCoffre(joueur, salle)
+		return true
+
+	default:
+		fmt.Println("\n🕸️  La salle est vide, seules des toiles d'araignée vous accueillent.")
+		return true
+	}
+}
+
+// ouvrirCoffre donne au joueur l'or et l'objet d'une salle
+func ouvrirCoffre(joueur *character.Character, salle Salle) {
+	if salle.Or > 0 {
+		fmt.Printf("💰 Vous récupérez %d pièces d'or !\n", salle.Or)
+		joueur.Argent += joueur.RetenirPartMercenaires(salle.Or)
+	}
+
+	switch salle.Objet {
+	case "":
+		return
+	case "Potion de Vie":
+		joueur.Inventaire.Potions++
+		fmt.Println("🧪 Vous trouvez une potion de vie !")
+	default:
+		if joueur.Inventaire.AddItem(item.NewItem(salle.Objet), 1) {
+			fmt.Printf("🎁 Vous obtenez : %s !\n", salle.Objet)
+		}
+	}
+}
@@ -4,18 +4,48 @@ package exploration
 
 import (
 	"fmt"
+	"math/rand"
 	"strings"
+	"world_of_milousques/auberge"
 	"world_of_milousques/banque"
+	"world_of_milousques/carte"
 	"world_of_milousques/character"
 	"world_of_milousques/commerce"
 	"world_of_milousques/craft"
+	"world_of_milousques/donjon"
 	"world_of_milousques/fight"
+	"world_of_milousques/guilde"
 	"world_of_milousques/item"
+	"world_of_milousques/meteo"
+	"world_of_milousques/region"
 	"world_of_milousques/ui"
 	"world_of_milousques/utils"
 	"world_of_milousques/world"
 )
 
+// Probabilités (en %) de réussir à échapper à une rencontre
+const (
+	chanceFuite          = 60
+	bonusFuiteVoleur     = 20
+	chanceFaufilerVoleur = 85
+)
+
+// Bonus (en %) aux chances de trouver un point d'intérêt caché en fouillant
+const bonusFouilleVoleur = 20
+
+// bonusFouilleObjets associe les objets utiles à la fouille à leur bonus (en %)
+var bonusFouilleObjets = map[string]int{
+	"Lanterne":               15,
+	"Pioche du Contremaître": 10,
+}
+
+// Chances (en %) qu'un autre monstre de la zone se joigne au combat
+const (
+	chanceRenfortEspece = 50 // Même espèce que le monstre attaqué
+	chanceRenfortAutre  = 15 // Autre espèce
+	tailleGroupeMax     = 3
+)
+
 // ExplorerMap lance la boucle principale d'exploration
 func ExplorerMap(joueur *character.Character) {
 	gameMap := world.NewMap()
@@ -31,6 +61,9 @@ func ExplorerMap(joueur *character.Character) {
 	// Marquer la zone actuelle comme découverte
 	joueur.MarquerZoneDecouverte(x, y)
 	
+	// Synchroniser l'horloge de la map (météo) avec celle du personnage
+	gameMap.MettreAJourTemps(joueur.TempsDeJeu)
+	
 	fmt.Println("\n🗺️  === BIENVENUE DANS LE MONDE OUVERT === 🗺️")
 	fmt.Println("Vous pouvez maintenant explorer le monde librement !")
 	fmt.Println("Utilisez les menus pour vous déplacer et interagir avec l'environnement.")
@@ -51,8 +84,14 @@ func ExplorerMap(joueur *character.Character) {
 			break
 		}
 		
+		// Verser les salaires des mercenaires échus pendant la dernière action
+		joueur.PayerMercenaires()
+		
 		// Afficher la map
+		gameMap.MettreAJourTemps(joueur.TempsDeJeu)
+		gameMap.MettreAJourEvenements(joueur)
 		gameMap.AfficherMap()
+		gameMap.AfficherDirections(joueur)
 		
 		// Afficher le menu principal d'exploration
 		if !menuPrincipalExploration(gameMap, joueur) {
@@ -81,8 +120,10 @@ func menuPrincipalExploration(gameMap *world.Map, joueur *character.Character) b
 	options := []string{
 		"Explorer cette zone",
 		"Se déplacer",
+		"Voyage rapide",
 		"Voir la carte complète",
 		"Afficher le statut du personnage",
+		"Exporter la carte en image",
 		"Quitter le jeu",
 	}
 	
@@ -95,12 +136,16 @@ func menuPrincipalExploration(gameMap *world.Map, joueur *character.Character) b
 	case 2:
 		seDeplacer(gameMap, joueur)
 	case 3:
+		voyageRapide(gameMap, joueur)
+	case 4:
 		gameMap.AfficherMap()
 		fmt.Println("\nAppuyez sur Entrée pour continuer...")
 		fmt.Scanln()
-	case 4:
-		afficherStatutPersonnage(joueur)
 	case 5:
+		afficherStatutPersonnage(joueur)
+	case 6:
+		exporterCarte(gameMap, joueur)
+	case 7:
 		fmt.Println("Merci d'avoir joué à World of Milousques !")
 		return false
 	}
@@ -108,14 +153,58 @@ func menuPrincipalExploration(gameMap *world.Map, joueur *character.Character) b
 	return true
 }
 
+// exporterCarte enregistre la carte actuelle dans une image à partager
+func exporterCarte(gameMap *world.Map, joueur *character.Character) {
+	options := []string{"Image PNG", "Image SVG (avec le nom des zones)", "Retour"}
+	ui.AfficherMenu("Exporter la carte", options)
+	choix := utils.ScanChoice("Quel format voulez-vous ? ", options)
+	
+	extension := ""
+	switch choix {
+	case 1:
+		extension = ".png"
+	case 2:
+		extension = ".svg"
+	default:
+		return
+	}
+	
+	chemin := "saves/carte_" + joueur.Nom + extension
+	if err := carte.Exporter(gameMap, chemin); err != nil {
+		fmt.Println("⚠️  Erreur lors de l'export de la carte:", err)
+	} else {
+		fmt.Printf("🖼️  Carte exportée dans %s\n", chemin)
+	}
+	
+	fmt.Println("\nAppuyez sur Entrée pour continuer...")
+	fmt.Scanln()
+}
+
 // explorerZoneActuelle ouvre le menu d'exploration de la zone actuelle
 func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 	zone := gameMap.GetCurrentZone()
+	conditions := gameMap.GetMeteoZone(zone)
 	
 	fmt.Printf("\n🏠  === %s === 🏠\n", zone.Nom)
 	fmt.Println(zone.Description)
+	fmt.Printf("%s | Météo : %s | %s\n", joueur.DescriptionTemps(), conditions.Libelle(), zone.LibelleDanger())
+	fmt.Println(conditions.Description)
+	if zone.Evenement != "" {
+		fmt.Printf("📯 %s\n", zone.Evenement)
+	}
+	if zone.Region != nil {
+		if raison := zone.Region.RaisonVerrou(joueur); raison != "" {
+			fmt.Printf("🌫️  Au-delà du bord du monde, quelque chose vous attend... %s.\n", raison)
+		}
+	}
 	fmt.Println()
 	
+	// Retenir les PNJs présents pour pouvoir les retrouver plus tard
+	x, y := joueur.ObtenirPosition()
+	for _, pnj := range zone.PNJs {
+		joueur.NoterPNJApercu(pnj.Nom, x, y)
+	}
+	
 	zoneActionCount := 0
 	maxZoneActions := 50 // Limite les actions dans une zone spécifique
 	
@@ -124,9 +213,6 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 		
 		options := []string{}
 		
-		// Vérifier si on est à Astrab pour les options spéciales
-		estAstrab := strings.Contains(zone.Nom, "Astrab")
-		
 		// Ajouter les options disponibles selon le contenu de la zone
 		if len(zone.Ressources) > 0 {
 			options = append(options, fmt.Sprintf("Récolter des ressources (%d disponibles)", len(zone.Ressources)))
@@ -140,11 +226,28 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 			options = append(options, fmt.Sprintf("Parler aux habitants (%d présents)", len(zone.PNJs)))
 		}
 		
-		// Options spéciales pour Astrab
-		if estAstrab {
-			options = append(options, "🔨 Aller à la forge")
-			options = append(options, "💰 Aller chez le marchand")
-			options = append(options, "🏦 Aller à la banque")
+		if zone.Donjon != nil {
+			options = append(options, fmt.Sprintf("🕳️  Descendre dans : %s", zone.Donjon.Nom))
+		}
+		
+		regionOuverte := zone.Region != nil && zone.Region.RaisonVerrou(joueur) == ""
+		if regionOuverte {
+			options = append(options, fmt.Sprintf("🌀 Franchir le bord du monde : %s", zone.Region.Nom))
+		}
+		
+		peutFouiller := zone.Biome != world.BiomeVille
+		if peutFouiller {
+			options = append(options, "🔍 Fouiller la zone")
+		}
+		
+		passages := passagesDecouverts(zone, joueur)
+		for _, passage := range passages {
+			options = append(options, fmt.Sprintf("🚪 Emprunter : %s", passage.Nom))
+		}
+		
+		// Établissements de la zone
+		for _, service := range zone.Services {
+			options = append(options, libelleService(service))
 		}
 		
 		options = append(options, "Retour à la carte")
@@ -178,7 +281,7 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 		if len(zone.Ressources) > 0 {
 			currentIndex++
 			if choix == currentIndex {
-				recolterRessources(zone, joueur)
+				recolterRessources(gameMap, zone, joueur)
 				continue
 			}
 		}
@@ -187,7 +290,7 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 		if len(zone.Monstres) > 0 {
 			currentIndex++
 			if choix == currentIndex {
-				affronterMonstre(zone, joueur)
+				affronterMonstre(gameMap, zone, joueur)
 				// Vérifier si le joueur est mort
 				if joueur.Pdv <= 0 {
 					fmt.Println("\n💀 Vous avez été vaincu...")
@@ -201,35 +304,60 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 		if len(zone.PNJs) > 0 {
 			currentIndex++
 			if choix == currentIndex {
-				parlerAuxPNJs(zone, joueur)
+				parlerAuxPNJs(gameMap, zone, joueur)
 				continue
 			}
 		}
 		
-		// Options spéciales pour Astrab
-		if estAstrab {
-			// Forge
+		// Descendre dans le donjon
+		if zone.Donjon != nil {
 			currentIndex++
 			if choix == currentIndex {
-				craft.AfficherForge(joueur)
+				donjon.Explorer(joueur, zone.Donjon)
+				if joueur.Pdv <= 0 {
+					fmt.Println("\n💀 Vous avez été vaincu...")
+					return
+				}
 				continue
 			}
-			
-			// Marchand
+		}
+		
+		// Région secrète
+		if regionOuverte {
 			currentIndex++
 			if choix == currentIndex {
-				commerce.AfficherMarchand(joueur)
+				region.Explorer(joueur, zone.Region)
+				if joueur.Pdv <= 0 {
+					fmt.Println("\n💀 Vous avez été vaincu...")
+					return
+				}
 				continue
 			}
-			
-			// Banque
+		}
+		
+		// Fouiller la zone
+		if peutFouiller {
 			currentIndex++
 			if choix == currentIndex {
-				banque.AfficherBanque(joueur)
+				fouillerZone(zone, joueur)
 				continue
 			}
 		}
 		
+		// Passages secrets découverts
+		if choix > currentIndex && choix <= currentIndex+len(passages) {
+			emprunterPassage(gameMap, passages[choix-currentIndex-1], joueur)
+			return
+		}
+		currentIndex += len(passages)
+		
+		// Établissements de la zone
+		if choix > currentIndex && choix <= currentIndex+len(zone.Services) {
+			utiliserService(zone.Services[choix-currentIndex-1], joueur)
+			continue
+		}
+		currentIndex += len(zone.Services)
+		
 		// Retour à la carte (toujours la dernière option)
 		currentIndex++
 		if choix == currentIndex {
@@ -250,33 +378,165 @@ func explorerZoneActuelle(gameMap *world.Map, joueur *character.Character) {
 	}
 }
 
+// fouillerZone cherche les points d'intérêt cachés de la zone encore inconnus du joueur
+// Chaque fouille prend une heure, la classe et l'équipement augmentent les chances
+func fouillerZone(zone *world.Zone, joueur *character.Character) {
+	x, y := joueur.ObtenirPosition()
+	joueur.AvancerTemps(1)
+	
+	bonus := 0
+	if joueur.Classe.Nom == "Voleur" {
+		bonus += bonusFouilleVoleur
+	}
+	for objet, bonusObjet := range bonusFouilleObjets {
+		if joueur.PossedeObjet(objet) {
+			bonus += bonusObjet
+		}
+	}
+	
+	fmt.Printf("\n🔍 Vous fouillez les environs pendant une heure... (bonus de fouille : +%d%%)\n", bonus)
+	
+	trouve := false
+	for _, point := range zone.PointsCaches {
+		if joueur.EstPointDecouvert(x, y, point.Nom) || rand.Intn(100) >= point.Chance+bonus {
+			continue
+		}
+		trouve = true
+		joueur.MarquerPointDecouvert(x, y, point.Nom)
+		decouvrirPoint(point, joueur)
+	}
+	
+	if !trouve {
+		fmt.Println("Vous ne trouvez rien de particulier.")
+	}
+	
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+	
+	fmt.Println("\nAppuyez sur Entrée pour continuer...")
+	fmt.Scanln()
+}
+
+// decouvrirPoint applique la découverte d'un point d'intérêt au joueur
+func decouvrirPoint(point world.PointInteret, joueur *character.Character) {
+	fmt.Printf("\n✨ Découverte : %s !\n", point.Nom)
+	fmt.Println(point.Description)
+	
+	switch point.Type {
+	case world.PointCoffre:
+		if point.Or > 0 {
+			joueur.Argent += point.Or
+			fmt.Printf("💰 Vous récupérez %d pièces d'or !\n", point.Or)
+		}
+		if point.Objet == "Potion de Vie" {
+			joueur.Inventaire.Potions++
+			fmt.Println("🧪 Vous trouvez une potion de vie !")
+		} else if point.Objet != "" && joueur.Inventaire.AddItem(item.NewItem(point.Objet), 1) {
+			fmt.Printf("🎁 Vous obtenez : %s !\n", point.Objet)
+		}
+	case world.PointPierre:
+		fmt.Println("📜 Vous déchiffrez l'inscription avec attention.")
+		joueur.GagnerExperience(point.Experience)
+	case world.PointPassage:
+		fmt.Printf("🚪 Un passage secret mène vers la zone (%d,%d). Vous pourrez l'emprunter depuis cette zone.\n",
+			point.Destination.X+1, point.Destination.Y+1)
+	}
+}
+
+// passagesDecouverts retourne les passages secrets de la zone déjà trouvés par le joueur
+func passagesDecouverts(zone *world.Zone, joueur *character.Character) []world.PointInteret {
+	x, y := joueur.ObtenirPosition()
+	passages := []world.PointInteret{}
+	for _, point := range zone.PointsCaches {
+		if point.Type == world.PointPassage && joueur.EstPointDecouvert(x, y, point.Nom) {
+			passages = append(passages, point)
+		}
+	}
+	return passages
+}
+
+// emprunterPassage transporte le joueur par un passage secret
+func emprunterPassage(gameMap *world.Map, passage world.PointInteret, joueur *character.Character) {
+	if !gameMap.EmprunterPassage(passage.Destination, joueur) {
+		fmt.Println("⛔ Le passage est effondré.")
+		return
+	}
+	
+	joueur.AvancerTemps(1)
+	gameMap.MettreAJourTemps(joueur.TempsDeJeu)
+	fmt.Printf("\n🚪 Vous empruntez %s et ressortez à : %s\n", passage.Nom, gameMap.GetCurrentZone().Nom)
+	
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+}
+
+// libelleService retourne l'option de menu d'un établissement
+func libelleService(service world.Service) string {
+	switch service.Type {
+	case world.ServiceForge:
+		return fmt.Sprintf("🔨 Aller à la forge (%s)", service.Nom)
+	case world.ServiceMarchand:
+		return fmt.Sprintf("💰 Aller chez le marchand (%s)", service.Nom)
+	case world.ServiceBanque:
+		return fmt.Sprintf("🏦 Aller à la banque (%s)", service.Nom)
+	case world.ServiceAuberge:
+		return fmt.Sprintf("🛏️  Aller à l'auberge (%s)", service.Nom)
+	case world.ServiceGuilde:
+		return fmt.Sprintf("🗡️  Aller à la guilde des mercenaires (%s)", service.Nom)
+	default:
+		return service.Nom
+	}
+}
+
+// utiliserService ouvre l'interface d'un établissement
+func utiliserService(service world.Service, joueur *character.Character) {
+	switch service.Type {
+	case world.ServiceForge:
+		craft.AfficherForge(joueur, service.Nom)
+	case world.ServiceMarchand:
+		commerce.AfficherMarchand(joueur, service.Nom)
+	case world.ServiceBanque:
+		banque.AfficherBanque(joueur, service.Nom)
+	case world.ServiceAuberge:
+		auberge.AfficherAuberge(joueur, service.Nom)
+	case world.ServiceGuilde:
+		guilde.AfficherGuilde(joueur, service.Nom)
+	}
+}
+
 // seDeplacer gère le déplacement du joueur sur la map avec ZQSD
 func seDeplacer(gameMap *world.Map, joueur *character.Character) {
 	fmt.Println("\nDéplacements possibles :")
 	fmt.Println("Z = Nord | S = Sud | Q = Ouest | D = Est | A = Annuler")
+	fmt.Println("V = Voyage rapide | R = Retour à Astrab")
 	
 	optionsDisponibles := []string{}
-	if gameMap.CanMoveTo("NORD") {
-		optionsDisponibles = append(optionsDisponibles, "Z (Nord)")
-	}
-	if gameMap.CanMoveTo("SUD") {
-		optionsDisponibles = append(optionsDisponibles, "S (Sud)")
-	}
-	if gameMap.CanMoveTo("OUEST") {
-		optionsDisponibles = append(optionsDisponibles, "Q (Ouest)")
-	}
-	if gameMap.CanMoveTo("EST") {
-		optionsDisponibles = append(optionsDisponibles, "D (Est)")
+	directionsLibres := 0
+	for _, d := range []struct{ code, libelle string }{
+		{"NORD", "Z (Nord)"}, {"SUD", "S (Sud)"}, {"OUEST", "Q (Ouest)"}, {"EST", "D (Est)"},
+	} {
+		if gameMap.GetZoneVoisine(d.code) == nil {
+			continue // Bord du monde
+		}
+		if raison := gameMap.RaisonBlocage(d.code, joueur); raison != "" {
+			optionsDisponibles = append(optionsDisponibles, fmt.Sprintf("%s ⛔ %s", d.libelle, raison))
+		} else {
+			optionsDisponibles = append(optionsDisponibles, fmt.Sprintf("%s - %s", d.libelle, gameMap.GetZoneVoisine(d.code).LibelleDanger()))
+			directionsLibres++
+		}
 	}
 	optionsDisponibles = append(optionsDisponibles, "A (Annuler)")
 	
-	if len(optionsDisponibles) == 1 {
+	if directionsLibres == 0 {
 		fmt.Println("Vous ne pouvez pas vous déplacer d'ici !")
-		return
 	}
 	
+	optionsDisponibles = append(optionsDisponibles, "V (Voyage rapide)", "R (Retour à Astrab)")
+	
 	ui.AfficherMenu("Choisir une direction", optionsDisponibles)
-	choixInput := utils.ScanString("Tapez Z/Q/S/D pour vous déplacer (ou A pour annuler) : ", 1)
+	choixInput := utils.ScanString("Tapez Z/Q/S/D pour vous déplacer, V/R pour voyager (ou A pour annuler) : ", 1)
 	choixInput = strings.ToUpper(strings.TrimSpace(choixInput))
 	
 	direction := ""
@@ -284,41 +544,47 @@ func seDeplacer(gameMap *world.Map, joueur *character.Character) {
 	
 	switch choixInput {
 	case "Z":
-		if gameMap.CanMoveTo("NORD") {
+		if gameMap.CanMoveToWithCharacter("NORD", joueur) {
 			direction = "NORD"
 			nomDirection = "Nord"
 		} else {
-			fmt.Println("Vous ne pouvez pas aller au Nord !")
+			fmt.Printf("Vous ne pouvez pas aller au Nord : %s !\n", gameMap.RaisonBlocage("NORD", joueur))
 			return
 		}
 	case "S":
-		if gameMap.CanMoveTo("SUD") {
+		if gameMap.CanMoveToWithCharacter("SUD", joueur) {
 			direction = "SUD"
 			nomDirection = "Sud"
 		} else {
-			fmt.Println("Vous ne pouvez pas aller au Sud !")
+			fmt.Printf("Vous ne pouvez pas aller au Sud : %s !\n", gameMap.RaisonBlocage("SUD", joueur))
 			return
 		}
 	case "Q":
-		if gameMap.CanMoveTo("OUEST") {
+		if gameMap.CanMoveToWithCharacter("OUEST", joueur) {
 			direction = "OUEST"
 			nomDirection = "Ouest"
 		} else {
-			fmt.Println("Vous ne pouvez pas aller à l'Ouest !")
+			fmt.Printf("Vous ne pouvez pas aller à l'Ouest : %s !\n", gameMap.RaisonBlocage("OUEST", joueur))
 			return
 		}
 	case "D":
-		if gameMap.CanMoveTo("EST") {
+		if gameMap.CanMoveToWithCharacter("EST", joueur) {
 			direction = "EST"
 			nomDirection = "Est"
 		} else {
-			fmt.Println("Vous ne pouvez pas aller à l'Est !")
+			fmt.Printf("Vous ne pouvez pas aller à l'Est : %s !\n", gameMap.RaisonBlocage("EST", joueur))
 			return
 		}
+	case "V":
+		voyageRapide(gameMap, joueur)
+		return
+	case "R":
+		voyagerVers(gameMap, joueur, world.PositionCapitale.X, world.PositionCapitale.Y)
+		return
 	case "A":
 		return
 	default:
-		fmt.Println("Direction invalide ! Utilisez Z/Q/S/D ou A.")
+		fmt.Println("Direction invalide ! Utilisez Z/Q/S/D, V, R ou A.")
 		return
 	}
 	
@@ -327,60 +593,351 @@ func seDeplacer(gameMap *world.Map, joueur *character.Character) {
 		fmt.Printf("\n🚶 Vous vous déplacez vers le %s...\n", nomDirection)
 		fmt.Printf("📍 Vous arrivez à : %s\n", newZone.Nom)
 		
+		conditions := appliquerTrajet(gameMap, joueur)
+		fmt.Printf("⏱️  Traversée : %dh (%s)\n", newZone.DureeTraversee(), joueur.DescriptionTemps())
+		fmt.Printf("🌦️  Météo : %s\n", conditions.Libelle())
+		if conditions.CoutDeplacement > 0 {
+			fmt.Printf("⏳ %s ralentit votre progression (+%dh de trajet)\n", conditions.Nom, conditions.CoutDeplacement)
+		}
+		
 		// Afficher le nombre total de zones découvertes
 		nombreZones := joueur.ObtenirNombreZonesDecouvertes()
 		fmt.Printf("🗺️  Zones découvertes : %d/25\n", nombreZones)
 		
+		// Un monstre peut surprendre le joueur à son arrivée, ou quelqu'un l'attendre sur la route
+		if gererRencontre(gameMap, joueur) {
+			gererEvenementRoute(gameMap, joueur)
+		}
+		
 		fmt.Println("\nAppuyez sur Entrée pour continuer...")
 		fmt.Scanln()
 	}
 }
 
+// appliquerTrajet fait avancer l'horloge après l'arrivée dans une zone
+// Le trajet dépend du terrain de la zone d'arrivée, plus si sa météo est mauvaise
+func appliquerTrajet(gameMap *world.Map, joueur *character.Character) meteo.Meteo {
+	zone := gameMap.GetCurrentZone()
+	conditions := gameMap.GetMeteoZone(zone)
+	joueur.AvancerTemps(gameMap.DureeTrajet(zone))
+	gameMap.MettreAJourTemps(joueur.TempsDeJeu)
+	return conditions
+}
+
+// voyageRapide propose de rejoindre directement une zone déjà découverte
+func voyageRapide(gameMap *world.Map, joueur *character.Character) {
+	fmt.Println("\n🧭 === VOYAGE RAPIDE === 🧭")
+	
+	options := []string{}
+	destinations := []world.Position{}
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			if !joueur.EstZoneDecouverte(x, y) {
+				continue
+			}
+			chemin := gameMap.CheminVers(x, y, joueur)
+			if len(chemin) == 0 {
+				continue // Zone actuelle ou inaccessible
+			}
+			options = append(options, fmt.Sprintf("%s (%d,%d) - %d étapes",
+				gameMap.GetZoneAt(x, y).Nom, x+1, y+1, len(chemin)))
+			destinations = append(destinations, world.Position{X: x, Y: y})
+		}
+	}
+	
+	if len(destinations) == 0 {
+		fmt.Println("Vous n'avez découvert aucune autre zone pour le moment.")
+		fmt.Println("Appuyez sur Entrée pour continuer...")
+		fmt.Scanln()
+		return
+	}
+	options = append(options, "Retour")
+	
+	ui.AfficherMenu("Choisir une destination", options)
+	choix := utils.ScanChoice("Où voulez-vous aller ? ", options)
+	
+	if choix == len(options) {
+		return // Retour
+	}
+	
+	destination := destinations[choix-1]
+	voyagerVers(gameMap, joueur, destination.X, destination.Y)
+}
+
+// voyagerVers déplace le joueur étape par étape jusqu'à une position
+// Chaque étape fait avancer l'horloge et une rencontre peut interrompre le voyage
+func voyagerVers(gameMap *world.Map, joueur *character.Character, x, y int) {
+	chemin := gameMap.CheminVers(x, y, joueur)
+	if len(chemin) == 0 {
+		fmt.Println("Vous êtes déjà à destination !")
+		return
+	}
+	
+	destination := gameMap.GetZoneAt(x, y)
+	fmt.Printf("\n🧭 Départ vers %s (%d,%d) : %d étapes\n", destination.Nom, x+1, y+1, len(chemin))
+	heureDepart := joueur.TempsDeJeu
+	
+	for etape, direction := range chemin {
+		if !gameMap.MoveToWithCharacter(direction, joueur) {
+			fmt.Println("⛔ Le chemin est bloqué, le voyage s'interrompt.")
+			break
+		}
+		
+		zone := gameMap.GetCurrentZone()
+		conditions := appliquerTrajet(gameMap, joueur)
+		posX, posY := joueur.ObtenirPosition()
+		fmt.Printf("🚶 Étape %d/%d : %s (%d,%d) | %s\n",
+			etape+1, len(chemin), zone.Nom, posX+1, posY+1, conditions.Libelle())
+		
+		// Une rencontre peut interrompre le voyage
+		if !gererRencontre(gameMap, joueur) || !gererEvenementRoute(gameMap, joueur) {
+			if joueur.Pdv <= 0 {
+				return
+			}
+			fmt.Println("🛑 Votre voyage est interrompu.")
+			break
+		}
+	}
+	
+	fmt.Printf("\n📍 Vous êtes à : %s\n", gameMap.GetCurrentZone().Nom)
+	fmt.Printf("⏱️  Durée du voyage : %dh (%s)\n", joueur.TempsDeJeu-heureDepart, joueur.DescriptionTemps())
+	
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+	
+	fmt.Println("\nAppuyez sur Entrée pour continuer...")
+	fmt.Scanln()
+}
+
+// gererRencontre résout la rencontre tirée lors du dernier déplacement
+// Le joueur peut combattre, tenter de fuir ou se faufiler s'il est Voleur
+// Retourne true si le joueur peut poursuivre sa route
+func gererRencontre(gameMap *world.Map, joueur *character.Character) bool {
+	rencontre := gameMap.PrendreRencontre()
+	if rencontre == nil {
+		return true
+	}
+	
+	zone := gameMap.GetCurrentZone()
+	ennemi := rencontre.Ennemi
+	fmt.Printf("\n⚠️  === RENCONTRE === ⚠️\n")
+	fmt.Printf("%s (PV: %d, Attaque: %d) surgit devant vous à %s !\n", ennemi.Nom, ennemi.Pv, ennemi.Attaque, zone.Nom)
+	
+	estVoleur := joueur.Classe.Nom == "Voleur"
+	chanceDeFuite := chanceFuite
+	if estVoleur {
+		chanceDeFuite += bonusFuiteVoleur
+	}
+	
+	options := []string{"Combattre", fmt.Sprintf("Fuir (%d%% de réussite)", chanceDeFuite)}
+	if estVoleur {
+		options = append(options, fmt.Sprintf("Se faufiler (%d%% de réussite)", chanceFaufilerVoleur))
+	}
+	
+	ui.AfficherMenu("Rencontre", options)
+	choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+	
+	switch choix {
+	case 2:
+		if rand.Intn(100) < chanceDeFuite {
+			fmt.Printf("🏃 Vous échappez à %s !\n", ennemi.Nom)
+			return false
+		}
+		fmt.Printf("❌ %s vous rattrape ! Le combat est inévitable.\n", ennemi.Nom)
+	case 3:
+		if rand.Intn(100) < chanceFaufilerVoleur {
+			fmt.Printf("🥷 Vous vous faufilez dans l'ombre, %s ne vous a pas vu.\n", ennemi.Nom)
+			return true
+		}
+		fmt.Printf("❌ Une brindille craque... %s vous a repéré !\n", ennemi.Nom)
+	}
+	
+	// Combat contre un monstre de la zone (retiré s'il est vaincu) ou un monstre errant
+	if rencontre.IndexMonstre >= 0 {
+		combattreMonstre(gameMap, zone, rencontre.IndexMonstre, joueur)
+	} else {
+		fmt.Printf("\n🥊 Combat contre %s !\n", ennemi.Nom)
+		fight.FightAvecMeteo(joueur, &ennemi, gameMap.GetMeteoZone(zone))
+		joueur.AvancerTemps(1)
+	}
+	
+	// Sauvegarde automatique après combat (victoire ou fuite)
+	if joueur.Pdv > 0 {
+		if err := joueur.Sauvegarder(); err != nil {
+			fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+		}
+	}
+	
+	return joueur.Pdv > 0
+}
+
+// gererEvenementRoute résout l'événement croisé sur la route lors du dernier déplacement
+// Retourne true si le joueur peut poursuivre sa route
+func gererEvenementRoute(gameMap *world.Map, joueur *character.Character) bool {
+	evenement := gameMap.PrendreEvenementRoute()
+	if evenement == nil {
+		return true
+	}
+	
+	fmt.Printf("\n🛤️  === %s === 🛤️\n", strings.ToUpper(evenement.Nom))
+	fmt.Println(evenement.Description)
+	
+	switch evenement.Type {
+	case world.RouteMarchand:
+		options := []string{"Jeter un œil à la marchandise", "Passer son chemin"}
+		ui.AfficherMenu(evenement.Nom, options)
+		if utils.ScanChoice("Que voulez-vous faire ? ", options) == 1 {
+			commerce.AfficherMarchand(joueur, evenement.Nom)
+		}
+		return true
+	case world.RouteBandits:
+		return rencontrerBandits(gameMap, evenement, joueur)
+	case world.RouteVoyageur:
+		aiderVoyageur(evenement, joueur)
+		return true
+	}
+	return true
+}
+
+// rencontrerBandits laisse le joueur payer une rançon, combattre ou tenter de fuir
+func rencontrerBandits(gameMap *world.Map, evenement *world.EvenementRoute, joueur *character.Character) bool {
+	rancon := joueur.Argent / 10
+	if rancon < 20 {
+		rancon = 20
+	}
+	
+	options := []string{
+		fmt.Sprintf("Payer la rançon (%d pièces d'or)", rancon),
+		"Combattre",
+		fmt.Sprintf("Fuir (%d%% de réussite)", chanceFuite),
+	}
+	ui.AfficherMenu(evenement.Nom, options)
+	choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+	
+	switch choix {
+	case 1:
+		if joueur.Argent >= rancon {
+			joueur.Argent -= rancon
+			fmt.Printf("💸 Vous tendez %d pièces d'or. Les bandits s'écartent en ricanant.\n", rancon)
+			return true
+		}
+		fmt.Println("💸 Votre bourse est trop légère... Les bandits dégainent leurs lames !")
+	case 3:
+		if rand.Intn(100) < chanceFuite {
+			fmt.Println("🏃 Vous prenez vos jambes à votre cou et semez les bandits !")
+			return true
+		}
+		fmt.Println("❌ Les bandits vous rattrapent !")
+	}
+	
+	bandit := evenement.Bandit
+	fmt.Printf("\n🥊 Combat contre %s !\n", bandit.Nom)
+	fight.FightAvecMeteo(joueur, &bandit, gameMap.GetMeteoZone(gameMap.GetCurrentZone()))
+	joueur.AvancerTemps(1)
+	
+	if bandit.Pv <= 0 {
+		butin := 30 + rand.Intn(41)
+		fmt.Printf("💰 Vous récupérez le butin des bandits : %d pièces d'or !\n", butin)
+		joueur.Argent += joueur.RetenirPartMercenaires(butin)
+	}
+	
+	if joueur.Pdv > 0 {
+		if err := joueur.Sauvegarder(); err != nil {
+			fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+		}
+	}
+	return joueur.Pdv > 0
+}
+
+// aiderVoyageur propose de rendre service à un voyageur en lui donnant les objets demandés
+func aiderVoyageur(evenement *world.EvenementRoute, joueur *character.Character) {
+	possede := joueur.Inventaire.CompterItem(evenement.ObjetDemande)
+	if evenement.ObjetDemande == "Potion de Vie" {
+		possede = joueur.Inventaire.Potions
+	}
+	
+	fmt.Printf("📜 Demande : %dx %s (vous en avez %d) | 🎁 Récompense : %d pièces d'or\n",
+		evenement.QuantiteDemandee, evenement.ObjetDemande, possede, evenement.RecompenseOr)
+	
+	options := []string{"Aider le voyageur", "Passer son chemin"}
+	ui.AfficherMenu(evenement.Nom, options)
+	if utils.ScanChoice("Que voulez-vous faire ? ", options) != 1 {
+		fmt.Printf("%s vous regarde vous éloigner tristement.\n", evenement.Nom)
+		return
+	}
+	
+	if possede < evenement.QuantiteDemandee {
+		fmt.Printf("❌ Il vous manque %d %s.\n", evenement.QuantiteDemandee-possede, evenement.ObjetDemande)
+		return
+	}
+	
+	if evenement.ObjetDemande == "Potion de Vie" {
+		joueur.Inventaire.Potions -= evenement.QuantiteDemandee
+	} else {
+		joueur.Inventaire.RetirerItem(evenement.ObjetDemande, evenement.QuantiteDemandee)
+	}
+	joueur.Argent += evenement.RecompenseOr
+	fmt.Printf("🙏 %s vous remercie chaleureusement et vous donne %d pièces d'or !\n", evenement.Nom, evenement.RecompenseOr)
+	joueur.GagnerExperience(25)
+	
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+}
+
 // recolterRessources permet au joueur de récolter des ressources
-func recolterRessources(zone *world.Zone, joueur *character.Character) {
+// La météo de la zone augmente ou diminue la quantité récoltée
+func recolterRessources(gameMap *world.Map, zone *world.Zone, joueur *character.Character) {
 	if len(zone.Ressources) == 0 {
 		fmt.Println("Il n'y a pas de ressources à récolter ici.")
 		return
 	}
 	
+	conditions := gameMap.GetMeteoZone(zone)
+	
 	fmt.Println("\n🌿 === RÉCOLTE DE RESSOURCES === 🌿")
 	fmt.Println("Ressources disponibles dans cette zone :")
 	
 	for i, ressource := range zone.Ressources {
 		fmt.Printf("%d. %s (Valeur: %d pièces)\n", i+1, ressource.Nom, ressource.Valeur)
 	}
+	if conditions.ModificateurRecolte != 0 {
+		fmt.Printf("%s : %+d%% de ressources récoltées\n", conditions.Libelle(), conditions.ModificateurRecolte)
+	}
 	
 	options := []string{"Récolter toutes les ressources", "Retour"}
 	ui.AfficherMenu("Récolte", options)
 	choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
 	
 	if choix == 1 {
-		// Récolter toutes les ressources
-		joueur.Inventaire.Recolter(zone.Ressources)
-		fmt.Printf("✅ Vous avez récolté %d ressources !\n", len(zone.Ressources))
-		
-		// Sauvegarder l'état complet de la zone dans le personnage
-		x, y := joueur.ObtenirPosition()
-		
-		// Convertir les ressources et monstres restants
-		ressourcesNoms := []string{}
-		for _, res := range zone.Ressources {
-			ressourcesNoms = append(ressourcesNoms, res.Nom)
-		}
-		
-		monstresRestants := []character.MonstreState{}
-		for _, mon := range zone.Monstres {
-			monstresRestants = append(monstresRestants, character.MonstreState{
-				Nom: mon.Nom,
-				Pv: mon.Pv,
-				Attaque: mon.Attaque,
-			})
+		// Appliquer la météo à la quantité récoltée
+		quantite := conditions.AppliquerRecolte(len(zone.Ressources))
+		recoltees := make([]item.Item, 0, quantite)
+		restantes := []item.Item{}
+		if quantite < len(zone.Ressources) {
+			recoltees = append(recoltees, zone.Ressources[:quantite]...)
+			restantes = append(restantes, zone.Ressources[quantite:]...)
+			fmt.Printf("%s : %d ressources restent hors d'atteinte, revenez plus tard !\n",
+				conditions.Libelle(), len(restantes))
+		} else {
+			recoltees = append(recoltees, zone.Ressources...)
+			for i := 0; len(recoltees) < quantite; i++ {
+				recoltees = append(recoltees, zone.Ressources[i%len(zone.Ressources)])
+			}
+			if quantite > len(zone.Ressources) {
+				fmt.Printf("%s : vous trouvez %d ressources supplémentaires !\n",
+					conditions.Libelle(), quantite-len(zone.Ressources))
+			}
 		}
 		
-		joueur.SauvegarderEtatZoneComplete(x, y, ressourcesNoms, monstresRestants)
+		joueur.Inventaire.Recolter(recoltees)
+		fmt.Printf("✅ Vous avez récolté %d ressources !\n", len(recoltees))
+		joueur.AvancerTemps(1)
 		
-		// Vider les ressources de la zone
-		zone.Ressources = []item.Item{}
+		// Mettre à jour la zone puis sauvegarder son état complet dans le personnage
+		zone.Ressources = restantes
+		sauvegarderEtatZone(zone, joueur)
 		
 		// Sauvegarde automatique après récolte
 		if err := joueur.Sauvegarder(); err != nil {
@@ -394,8 +951,32 @@ func recolterRessources(zone *world.Zone, joueur *character.Character) {
 	}
 }
 
+// sauvegarderEtatZone enregistre les ressources et monstres restants de la zone actuelle dans le personnage
+func sauvegarderEtatZone(zone *world.Zone, joueur *character.Character) {
+	x, y := joueur.ObtenirPosition()
+	
+	// Convertir les ressources et monstres restants
+	ressourcesNoms := []string{}
+	for _, res := range zone.Ressources {
+		ressourcesNoms = append(ressourcesNoms, res.Nom)
+	}
+	
+	monstresRestants := []character.MonstreState{}
+	for _, mon := range zone.Monstres {
+		monstresRestants = append(monstresRestants, character.MonstreState{
+			Nom: mon.Nom,
+			Pv: mon.Pv,
+			Attaque: mon.Attaque,
+			PvMax: mon.PvMax,
+			Temps: joueur.TempsDeJeu,
+		})
+	}
+	
+	joueur.SauvegarderEtatZoneComplete(x, y, ressourcesNoms, monstresRestants)
+}
+
 // affronterMonstre permet au joueur d'affronter les monstres de la zone
-func affronterMonstre(zone *world.Zone, joueur *character.Character) {
+func affronterMonstre(gameMap *world.Map, zone *world.Zone, joueur *character.Character) {
 	if len(zone.Monstres) == 0 {
 		fmt.Println("Il n'y a pas de monstres à affronter ici.")
 		return
@@ -419,43 +1000,7 @@ func affronterMonstre(zone *world.Zone, joueur *character.Character) {
 	}
 	
 	// Combat
-	monstreChoisi := &zone.Monstres[choix-1]
-	fmt.Printf("\n🥊 Combat contre %s !\n", monstreChoisi.Nom)
-	
-	fight.Fight(joueur, monstreChoisi)
-	
-	// Si le monstre est vaincu, le retirer de la zone
-	if monstreChoisi.Pv <= 0 {
-		// Créer une nouvelle slice sans le monstre vaincu
-		nouveauxMonstres := make([]fight.Ennemi, 0)
-		for i, m := range zone.Monstres {
-			if i != choix-1 {
-				nouveauxMonstres = append(nouveauxMonstres, m)
-			}
-		}
-		zone.Monstres = nouveauxMonstres
-		fmt.Println("🏆 Le monstre a été vaincu et ne reviendra plus dans cette zone !")
-		
-		// Sauvegarder l'état complet de la zone après modification des monstres
-		x, y := joueur.ObtenirPosition()
-		
-		// Convertir les ressources et monstres restants
-		ressourcesNoms := []string{}
-		for _, res := range zone.Ressources {
-			ressourcesNoms = append(ressourcesNoms, res.Nom)
-		}
-		
-		monstresRestants := []character.MonstreState{}
-		for _, mon := range zone.Monstres {
-			monstresRestants = append(monstresRestants, character.MonstreState{
-				Nom: mon.Nom,
-				Pv: mon.Pv,
-				Attaque: mon.Attaque,
-			})
-		}
-		
-		joueur.SauvegarderEtatZoneComplete(x, y, ressourcesNoms, monstresRestants)
-	}
+	combattreMonstre(gameMap, zone, choix-1, joueur)
 	
 	// Sauvegarde automatique après combat (victoire ou fuite)
 	if err := joueur.Sauvegarder(); err != nil {
@@ -468,14 +1013,100 @@ func affronterMonstre(zone *world.Zone, joueur *character.Character) {
 	fmt.Scanln()
 }
 
+// combattreMonstre lance le combat contre un monstre de la zone, que d'autres monstres peuvent rejoindre
+// Les monstres vaincus sont retirés de la zone
+func combattreMonstre(gameMap *world.Map, zone *world.Zone, index int, joueur *character.Character) {
+	groupe := []*fight.Ennemi{&zone.Monstres[index]}
+	for _, i := range renfortsMonstre(zone, index) {
+		groupe = append(groupe, &zone.Monstres[i])
+	}
+	
+	if len(groupe) == 1 {
+		fmt.Printf("\n🥊 Combat contre %s !\n", groupe[0].Nom)
+	} else {
+		noms := []string{}
+		for _, monstre := range groupe {
+			noms = append(noms, monstre.Nom)
+		}
+		fmt.Printf("\n🥊 Combat contre %s ! D'autres monstres accourent : %s\n", groupe[0].Nom, strings.Join(noms[1:], ", "))
+	}
+	
+	fight.FightGroupe(joueur, groupe, gameMap.GetMeteoZone(zone))
+	joueur.AvancerTemps(1)
+	
+	// Noter les vaincus avant de retirer les monstres de la zone (les pointeurs du groupe n'y seront plus valides)
+	vaincus := []string{}
+	apprivoises := []string{}
+	for _, monstre := range groupe {
+		if monstre.EstApprivoise() {
+			apprivoises = append(apprivoises, monstre.Nom)
+		} else if monstre.Pv <= 0 {
+			vaincus = append(vaincus, monstre.Nom)
+		}
+	}
+	if len(vaincus) == 0 && len(apprivoises) == 0 {
+		// Fuite : les monstres gardent leurs blessures, qui guériront avec le temps
+		sauvegarderEtatZone(zone, joueur)
+		return
+	}
+	
+	// Créer une nouvelle slice sans les monstres vaincus
+	nouveauxMonstres := make([]fight.Ennemi, 0)
+	for _, m := range zone.Monstres {
+		if m.Pv > 0 {
+			nouveauxMonstres = append(nouveauxMonstres, m)
+		}
+	}
+	zone.Monstres = nouveauxMonstres
+	
+	for _, nom := range apprivoises {
+		fmt.Printf("🐾 %s vous suit désormais et quitte cette zone.\n", nom)
+	}
+	for _, nom := range vaincus {
+		fmt.Printf("🏆 %s a été vaincu et ne reviendra plus dans cette zone !\n", nom)
+		if butin := zone.ButinOr(); butin > 0 {
+			fmt.Printf("💰 Il laisse derrière lui %d pièces d'or.\n", butin)
+			joueur.Argent += joueur.RetenirPartMercenaires(butin)
+		}
+	}
+	
+	// Sauvegarder l'état complet de la zone après modification des monstres
+	sauvegarderEtatZone(zone, joueur)
+	
+	// Faire avancer les événements du monde en cours
+	for _, nom := range vaincus {
+		gameMap.SignalerVictoire(joueur, nom)
+	}
+}
+
+// renfortsMonstre tire les autres monstres de la zone qui rejoignent le combat contre le monstre attaqué
+// Ceux de la même espèce accourent plus volontiers ; le groupe ne dépasse pas tailleGroupeMax monstres
+func renfortsMonstre(zone *world.Zone, index int) []int {
+	renforts := []int{}
+	for i, monstre := range zone.Monstres {
+		if i == index || len(renforts)+1 >= tailleGroupeMax {
+			continue
+		}
+		chance := chanceRenfortAutre
+		if monstre.Nom == zone.Monstres[index].Nom {
+			chance = chanceRenfortEspece
+		}
+		if rand.Intn(100) < chance {
+			renforts = append(renforts, i)
+		}
+	}
+	return renforts
+}
+
 // parlerAuxPNJs permet d'interagir avec les PNJs de la zone
-func parlerAuxPNJs(zone *world.Zone, joueur *character.Character) {
+func parlerAuxPNJs(gameMap *world.Map, zone *world.Zone, joueur *character.Character) {
 	if len(zone.PNJs) == 0 {
 		fmt.Println("Il n'y a personne à qui parler ici.")
 		return
 	}
 	
 	fmt.Println("\n💬 === HABITANTS DE LA ZONE === 💬")
+	afficherDonneursAbsents(gameMap, zone, joueur)
 	
 	options := make([]string, 0)
 	for _, pnj := range zone.PNJs {
@@ -494,6 +1125,12 @@ func parlerAuxPNJs(zone *world.Zone, joueur *character.Character) {
 	fmt.Printf("\n🗣️  %s :\n", pnj.Nom)
 	fmt.Printf("\"%s\"\n", pnj.Dialogue)
 	
+	// Les habitants parlent des événements en cours
+	gameMap.DeclencherEvenementsPNJ(joueur, pnj.Nom)
+	for _, rumeur := range gameMap.RumeursEvenements() {
+		fmt.Printf("\"%s\"\n", rumeur)
+	}
+	
 	// Vérifier si le joueur a une quête à rendre à ce PNJ
 	queteARendreExiste := false
 	for _, q := range joueur.Quetes {
@@ -568,6 +1205,34 @@ func parlerAuxPNJs(zone *world.Zone, joueur *character.Character) {
 	fmt.Scanln()
 }
 
+// afficherDonneursAbsents indique où ont été vus pour la dernière fois les donneurs de quêtes en cours
+// qui ne sont pas dans la zone
+func afficherDonneursAbsents(gameMap *world.Map, zone *world.Zone, joueur *character.Character) {
+	for _, q := range joueur.Quetes {
+		if q.DonneurPNJ == "" || q.Rendue {
+			continue
+		}
+		
+		present := false
+		for _, pnj := range zone.PNJs {
+			if pnj.Nom == q.DonneurPNJ {
+				present = true
+				break
+			}
+		}
+		if present {
+			continue
+		}
+		
+		if vu, existe := joueur.DernierApercuPNJ(q.DonneurPNJ); existe {
+			fmt.Printf("📍 %s n'est pas ici. Vu pour la dernière fois à %s (%d,%d), jour %d à %02dh.\n",
+				q.DonneurPNJ, gameMap.GetZoneAt(vu.X, vu.Y).Nom, vu.X+1, vu.Y+1, vu.Temps/24+1, vu.Temps%24)
+		} else {
+			fmt.Printf("📍 %s n'est pas ici, et personne ne sait où le trouver.\n", q.DonneurPNJ)
+		}
+	}
+}
+
 // afficherStatutPersonnage affiche les informations du personnage avec options
 func afficherStatutPersonnage(joueur *character.Character) {
 	statutActionCount := 0
@@ -586,6 +1251,9 @@ func afficherStatutPersonnage(joueur *character.Character) {
 			options = append(options, "🧪 Utiliser une potion")
 		}
 		options = append(options, "🎒 Gérer l'inventaire")
+		if len(joueur.Compagnons) > 1 {
+			options = append(options, "🐾 Choisir le compagnon actif")
+		}
 		options = append(options, "Retour")
 		
 		if len(options) > 1 { // Plus que juste "Retour"
@@ -618,6 +1286,15 @@ func afficherStatutPersonnage(joueur *character.Character) {
 				continue
 			}
 			
+			// Choisir le compagnon actif
+			if len(joueur.Compagnons) > 1 {
+				currentIndex++
+				if choix == currentIndex {
+					choisirCompagnonActif(joueur)
+					continue
+				}
+			}
+			
 			// Retour (dernière option)
 			currentIndex++
 			if choix == currentIndex {
@@ -860,6 +1537,23 @@ func gererNouvelleQuete(joueur *character.Character, pnj world.PNJ) {
 	}
 }
 
+// choisirCompagnonActif fait choisir le compagnon qui combattra en premier
+func choisirCompagnonActif(joueur *character.Character) {
+	options := []string{}
+	for _, compagnon := range joueur.Compagnons {
+		options = append(options, fmt.Sprintf("%s (%s niveau %d, PV %d/%d)", compagnon.Nom, compagnon.Espece, compagnon.Niveau, compagnon.Pv, compagnon.PvMax))
+	}
+	options = append(options, "Retour")
+	
+	ui.AfficherMenu("Compagnon actif", options)
+	choix := utils.ScanChoice("Quel compagnon doit combattre ? ", options)
+	if choix < 1 || choix > len(joueur.Compagnons) {
+		return
+	}
+	joueur.ChoisirCompagnonActif(choix - 1)
+	fmt.Printf("🐾 %s combattra désormais à vos côtés.\n", joueur.Compagnons[0].Nom)
+}
+
 // afficherStatutComplet affiche le statut détaillé du personnage de façon compacte
 func afficherStatutComplet(joueur *character.Character) {
 	fmt.Printf("Nom : %s | Classe : %s | Niveau : %d\n", joueur.Nom, joueur.Classe.Nom, joueur.Niveau)
@@ -867,6 +1561,7 @@ func afficherStatutComplet(joueur *character.Character) {
 		joueur.Pdv, joueur.PdvMax, joueur.Mana, joueur.ManaMax, joueur.Experience, joueur.CalculerXPRequis())
 	fmt.Printf("💰 Argent : %d | 🧆 Potions : %d | 🗺️ Zones : %d/25\n", 
 		joueur.Argent, joueur.Inventaire.Potions, joueur.ObtenirNombreZonesDecouvertes())
+	fmt.Printf("🕒 %s\n", joueur.DescriptionTemps())
 	
 	// Équipement compact
 	equipements := []string{}
@@ -905,6 +1600,24 @@ func afficherStatutComplet(joueur *character.Character) {
 		fmt.Println()
 	}
 	
+	// Compagnons apprivoisés
+	for i, compagnon := range joueur.Compagnons {
+		statut := ""
+		if compagnon.Pv <= 0 {
+			statut = " (K.O.)"
+		} else if joueur.CompagnonActif() == &joueur.Compagnons[i] {
+			statut = " (actif)"
+		}
+		fmt.Printf("🐾 %s, %s niveau %d : PV %d/%d, Attaque %d%s\n",
+			compagnon.Nom, compagnon.Espece, compagnon.Niveau, compagnon.Pv, compagnon.PvMax, compagnon.Attaque, statut)
+	}
+	
+	// Mercenaires engagés
+	for _, mercenaire := range joueur.Mercenaires {
+		fmt.Printf("🗡️  %s, %s : PV %d/%d, Attaque %d | %d or/jour, %d%% du butin\n",
+			mercenaire.Nom, mercenaire.Role, mercenaire.Pv, mercenaire.PvMax, mercenaire.Attaque, mercenaire.Salaire, mercenaire.PartButin)
+	}
+	
 	joueur.AfficherQuetes()
 }
 
@@ -4,7 +4,13 @@ package fight
 
 import (
 	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
 	"world_of_milousques/character"
+	"world_of_milousques/item"
+	"world_of_milousques/meteo"
+	"world_of_milousques/sorts"
 	"world_of_milousques/ui"
 	"world_of_milousques/utils"
 )
@@ -13,151 +19,1593 @@ type Ennemi struct {
 	Nom     string
 	Pv      int
 	Attaque int
+	Defense int // Réduit les dégâts physiques reçus
+	Vitesse int // Initiative et esquive face à l'agilité du joueur
+	// Résistances en % par élément : positif = résistance (100 = immunité), négatif = faiblesse
+	Resistances map[sorts.Element]int
+	EffetAttaque string // Effet de statut que ses attaques peuvent appliquer ("" = aucun)
+	ChanceEffet  int    // Probabilité (en %) d'appliquer l'effet
+	Effets       Effets // Effets de statut actifs pendant le combat
+	// Capacités spéciales et façon de les choisir
+	PvMax        int
+	Capacites    []Capacite
+	Comportement Comportement
+	recharges    map[string]int // Tours restants avant de pouvoir réutiliser une capacité
+	// État propre au combat en cours
+	attaqueCombat int                 // Attaque après l'influence de la météo
+	agitAvant     bool                // L'ennemi a gagné l'initiative et agit avant le joueur
+	boss          *Boss               // Phases du boss (nil pour un ennemi ordinaire)
+	phase         int                 // Nombre de phases du boss déjà déclenchées
+	enrage        bool                // L'ennemi est enragé et son attaque augmente à chaque tour
+	apprivoise    bool                // L'ennemi a été apprivoisé par le joueur et quitte le combat
+	compagnon     character.Compagnon // Compagnon issu de l'apprivoisement, ajouté à la fin du combat
+	coupGratuit   bool                // L'ennemi a déjà frappé ce tour en barrant la fuite du joueur
 }
 
-func Fight(joueur *character.Character, ennemi *Ennemi) {
-	tourCount := 0
-	maxTours := 100 // Limite le nombre de tours pour éviter les combats infinis
+// allie est un combattant du camp du joueur qui agit seul pendant le combat
+type allie struct {
+	nom       string
+	emoji     string // 🐾 pour un compagnon, 🗡️ pour un mercenaire
+	pv        *int // PV conservés par le personnage d'un combat à l'autre
+	pvMax     int
+	attaque   int
+	vitesse   int
+	capacites []Capacite
+	recharges map[string]int
+}
+
+// Réglages des jets de combat
+const (
+	precisionBase      = 90 // Chance de toucher (en %) à agilité égale
+	precisionParPoint  = 2  // Points de % gagnés ou perdus par point d'écart d'agilité
+	precisionMin       = 50
+	precisionMax       = 98
+	critiqueEnnemi     = 5   // Chance de coup critique des ennemis (en %)
+	multiplicateurCrit = 150 // Dégâts d'un coup critique (en %) par défaut
+	vitesseParDefaut   = 10
+)
+
+// Réglages des actions sans mana
+const (
+	degatsAttaqueBase = 10 // Dégâts de l'attaque de base, auxquels s'ajoute l'attaque de l'arme
+	reductionGarde    = 50 // Réduction (en %) des dégâts reçus en se défendant
+	manaGarde         = 10 // Mana récupéré en se défendant
+)
+
+// Réglages de la fuite et de la récupération des ennemis blessés
+const (
+	fuiteBase            = 60 // Chance de fuir (en %) face à un ennemi aussi rapide que le joueur
+	fuiteParPoint        = 3  // Points de % gagnés ou perdus par point d'écart avec l'ennemi le plus rapide
+	fuiteMin             = 20
+	fuiteMax             = 95
+	chancePerteOr        = 30 // Chance (en %) de laisser tomber de l'or en fuyant
+	perteOr              = 10 // Part de l'or (en %) perdue dans la fuite
+	regenerationParHeure = 10 // PV (en % des PV max) regagnés par heure de jeu par un ennemi blessé
+)
+
+// Réglages de l'apprivoisement et des alliés
+const (
+	effetApprivoisement    = "Apprivoisement" // Effet de combat des objets qui apprivoisent
+	seuilApprivoisement    = 30               // PV (en % des PV max) sous lesquels un Milousque peut être apprivoisé
+	chanceApprivoisement   = 40               // Chance (en %) d'apprivoiser un Milousque au seuil
+	apprivoisementParPoint = 2                // Chance gagnée par % de PV sous le seuil
+	chanceCibleAllie       = 25               // Chance (en %) qu'un ennemi attaque un allié plutôt que le joueur
+)
+
+// Réglages de l'enragement des ennemis ordinaires (les boss ont les leurs)
+const (
+	tourEnrageParDefaut  = 50 // Tour à partir duquel un ennemi s'enrage
+	bonusEnrageParDefaut = 20 // Attaque gagnée (en % de l'attaque de base) à chaque tour d'enragement
+)
+
+// Comportement définit comment un ennemi choisit ses capacités
+type Comportement string
+
+const (
+	ComportementAgressif Comportement = "agressif" // Privilégie les capacités offensives
+	ComportementPrudent  Comportement = "prudent"  // Se protège ou se soigne dès qu'il est blessé
+	ComportementRuse     Comportement = "rusé"     // Cherche d'abord à gêner le joueur
+)
+
+// Capacite représente une action spéciale qu'un ennemi peut utiliser à la place de son attaque
+type Capacite struct {
+	Nom            string
+	Description    string
+	Multiplicateur int    // Dégâts en % de l'attaque de l'ennemi (0 = aucun dégât)
+	DrainMana      int    // Mana volé au joueur
+	Soin           int    // PV récupérés en % des PV maximum
+	Effet          string // Effet de statut : sur l'ennemi s'il est bénéfique, sinon sur le joueur
+	SeuilPv        int    // Utilisable seulement sous ce % de PV (0 = toujours)
+	Recharge       int    // Tours d'attente avant de la réutiliser
+	Chance         int    // Probabilité (en %) de l'utiliser quand elle est disponible
+}
+
+// Profil regroupe la défense, les résistances et l'effet d'attaque d'une espèce de monstre
+type Profil struct {
+	Defense      int
+	Vitesse      int
+	Resistances  map[sorts.Element]int
+	EffetAttaque string
+	ChanceEffet  int
+}
+
+// bestiaire associe à chaque espèce sa défense et ses résistances
+// Les monstres restaurés depuis une sauvegarde ne gardent que leur nom, leurs PV et leur attaque
+var bestiaire = map[string]Profil{
+	"Chacha Agressif":        {Defense: 0, Vitesse: 12, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25}},
+	"Chacha Errant":          {Defense: 2, Vitesse: 12, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25}},
+	"Loup des chemins":       {Defense: 3, Vitesse: 14, Resistances: map[sorts.Element]int{sorts.ElementFeu: -25}, EffetAttaque: "Saignement", ChanceEffet: 25},
+	"Moutmout":               {Defense: 2, Vitesse: 8, Resistances: map[sorts.Element]int{sorts.ElementFeu: -50, sorts.ElementPhysique: 25}},
+	"Retourneur de panneaux": {Defense: 6, Vitesse: 10, Resistances: map[sorts.Element]int{sorts.ElementArcane: -25, sorts.ElementTerre: 25}, EffetAttaque: "Étourdissement", ChanceEffet: 20},
+	"Ecumouilles":            {Defense: 4, Vitesse: 11, Resistances: map[sorts.Element]int{sorts.ElementFeu: 50, sorts.ElementTerre: -25}},
+	"Crabe Hijacob":          {Defense: 10, Vitesse: 16, Resistances: map[sorts.Element]int{sorts.ElementTerre: -50, sorts.ElementFeu: 25}, EffetAttaque: "Saignement", ChanceEffet: 30},
+	"Moumoule":               {Defense: 15, Vitesse: 4, Resistances: map[sorts.Element]int{sorts.ElementFeu: -50, sorts.ElementPoison: 50}},
+	"Kairis":                 {Defense: 6, Vitesse: 12, Resistances: map[sorts.Element]int{sorts.ElementPoison: -50, sorts.ElementArcane: 25}, EffetAttaque: "Poison", ChanceEffet: 25},
+	"Kairis Envahisseur":     {Defense: 6, Vitesse: 12, Resistances: map[sorts.Element]int{sorts.ElementPoison: -50, sorts.ElementArcane: 25}, EffetAttaque: "Poison", ChanceEffet: 25},
+	"Kairis Mineur":          {Defense: 4, Vitesse: 10, Resistances: map[sorts.Element]int{sorts.ElementPoison: -50, sorts.ElementTerre: 25}},
+	"Kairis Foreur":          {Defense: 10, Vitesse: 8, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25, sorts.ElementTerre: 50}, EffetAttaque: "Armure brisée", ChanceEffet: 30},
+	"Contremaître Kairis":    {Defense: 10, Vitesse: 10, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25, sorts.ElementTerre: 50}, EffetAttaque: "Étourdissement", ChanceEffet: 20},
+	"Reine Kairis":           {Defense: 12, Vitesse: 9, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25, sorts.ElementArcane: 50, sorts.ElementFeu: 25}, EffetAttaque: "Poison", ChanceEffet: 40},
+	"Bandit de grand chemin": {Defense: 5, Vitesse: 13, Resistances: map[sorts.Element]int{sorts.ElementPoison: -25}, EffetAttaque: "Saignement", ChanceEffet: 25},
+	"Feu follet":             {Defense: 0, Vitesse: 18, Resistances: map[sorts.Element]int{sorts.ElementFeu: 100, sorts.ElementPhysique: 50, sorts.ElementArcane: -50}, EffetAttaque: "Brûlure", ChanceEffet: 40},
+	"Loup des brumes":        {Defense: 5, Vitesse: 15, Resistances: map[sorts.Element]int{sorts.ElementFeu: -25}, EffetAttaque: "Saignement", ChanceEffet: 30},
+	"Crabe titanesque":       {Defense: 15, Vitesse: 10, Resistances: map[sorts.Element]int{sorts.ElementTerre: -50, sorts.ElementFeu: 25}, EffetAttaque: "Armure brisée", ChanceEffet: 30},
+	"Gardien Milousque":      {Defense: 10, Vitesse: 11, Resistances: map[sorts.Element]int{sorts.ElementArcane: 25, sorts.ElementPoison: -25}},
+	"Milousque des Brumes":   {Defense: 8, Vitesse: 17, Resistances: map[sorts.Element]int{sorts.ElementFeu: 25, sorts.ElementArcane: -25}, EffetAttaque: "Poison", ChanceEffet: 30},
+	"Grand Milousque":        {Defense: 12, Vitesse: 14, Resistances: map[sorts.Element]int{sorts.ElementFeu: 25, sorts.ElementArcane: 25, sorts.ElementTerre: 25, sorts.ElementPoison: -25}, EffetAttaque: "Étourdissement", ChanceEffet: 20},
+}
+
+// GetProfil retourne la défense et les résistances d'une espèce (profil vide si elle est inconnue)
+func GetProfil(nom string) Profil {
+	return bestiaire[nom]
+}
+
+// GetCapacites retourne le comportement et les capacités spéciales d'une espèce
+func GetCapacites(nom string) (Comportement, []Capacite) {
+	switch nom {
+	case "Moumoule":
+		return ComportementPrudent, []Capacite{
+			{Nom: "Coquille", Description: "se referme dans sa coquille", Effet: "Carapace", SeuilPv: 40, Recharge: 4, Chance: 80},
+			{Nom: "Jet d'eau", Description: "crache un puissant jet d'eau", Multiplicateur: 150, Recharge: 2, Chance: 30},
+		}
+	case "Moutmout":
+		return ComportementPrudent, []Capacite{
+			{Nom: "Laine épaisse", Description: "gonfle sa laine pour amortir les coups", Effet: "Bouclier", SeuilPv: 50, Recharge: 4, Chance: 50},
+		}
+	case "Kairis", "Kairis Envahisseur", "Kairis Mineur":
+		return ComportementRuse, []Capacite{
+			{Nom: "Siphon de mana", Description: "plante ses mandibules et aspire votre magie", Multiplicateur: 60, DrainMana: 20, Recharge: 2, Chance: 50},
+		}
+	case "Crabe Hijacob", "Crabe titanesque":
+		return ComportementRuse, []Capacite{
+			{Nom: "Danse du crabe", Description: "se met à danser de côté, insaisissable", Effet: "Esquive", Recharge: 3, Chance: 45},
+			{Nom: "Pince tranchante", Description: "referme sa pince de toutes ses forces", Multiplicateur: 140, Effet: "Saignement", Recharge: 3, Chance: 35},
+		}
+	case "Ecumouilles":
+		return ComportementAgressif, []Capacite{
+			{Nom: "Écume bouillonnante", Description: "vous asperge d'écume brûlante", Multiplicateur: 130, Effet: "Brûlure", Recharge: 3, Chance: 40},
+		}
+	case "Retourneur de panneaux":
+		return ComportementRuse, []Capacite{
+			{Nom: "Panneau trompeur", Description: "vous fait tourner en rond avec un panneau retourné", Effet: "Étourdissement", Recharge: 4, Chance: 35},
+		}
+	case "Feu follet":
+		return ComportementAgressif, []Capacite{
+			{Nom: "Embrasement", Description: "s'embrase et fonce sur vous", Multiplicateur: 120, Effet: "Brûlure", Recharge: 2, Chance: 50},
+		}
+	case "Reine Kairis":
+		return ComportementAgressif, []Capacite{
+			{Nom: "Siphon royal", Description: "aspire votre magie pour nourrir la ruche", Multiplicateur: 80, DrainMana: 30, Recharge: 3, Chance: 40},
+			{Nom: "Gelée royale", Description: "se nourrit de gelée royale", Soin: 15, SeuilPv: 50, Recharge: 5, Chance: 60},
+		}
+	case "Milousque des Brumes":
+		return ComportementRuse, []Capacite{
+			{Nom: "Voile de brume", Description: "disparaît dans la brume", Effet: "Esquive", Recharge: 3, Chance: 40},
+			{Nom: "Souffle glacé", Description: "souffle un vent glacial", Multiplicateur: 120, Effet: "Étourdissement", Recharge: 4, Chance: 30},
+		}
+	case "Grand Milousque":
+		return ComportementAgressif, []Capacite{
+			{Nom: "Rugissement", Description: "pousse un rugissement qui fait trembler l'île", Multiplicateur: 150, Effet: "Étourdissement", Recharge: 4, Chance: 35},
+			{Nom: "Plumage ancestral", Description: "se drape dans son plumage ancestral", Soin: 20, SeuilPv: 30, Recharge: 6, Chance: 70},
+		}
+	default:
+		return ComportementAgressif, []Capacite{}
+	}
+}
+
+// Phase d'un boss, déclenchée quand ses PV passent sous un seuil
+type Phase struct {
+	SeuilPv    int        // Seuil de déclenchement (en % des PV max)
+	Dialogue   string     // Réplique du boss au début de la phase
+	Capacites  []Capacite // Capacités acquises pour le reste du combat
+	Renforts   []Ennemi   // Ennemis invoqués dans le combat
+	ModAttaque int        // Attaque gagnée (en % de l'attaque de base)
+}
+
+// Boss décrit le déroulement d'un combat de boss
+type Boss struct {
+	Introduction string  // Réplique du boss au début du combat
+	Phases       []Phase // Dans l'ordre des seuils décroissants
+	TourEnrage   int     // Tour à partir duquel le boss s'enrage
+	BonusEnrage  int     // Attaque gagnée (en % de l'attaque de base) à chaque tour d'enragement
+	Butin        string  // Objet unique obtenu la première fois que le boss est vaincu
+}
+
+// GetBoss retourne les phases d'un boss à partir de son nom
+func GetBoss(nom string) (Boss, bool) {
+	switch nom {
+	case "Contremaître Kairis":
+		return Boss{
+			Introduction: "Encore un curieux... Au travail, les gars, on a de la visite !",
+			Phases: []Phase{
+				{SeuilPv: 50, Dialogue: "Des renforts ! Et plus vite que ça !",
+					Renforts:  []Ennemi{{Nom: "Kairis Mineur", Pv: 70, Attaque: 25}},
+					Capacites: []Capacite{{Nom: "Coup de pioche", Description: "abat sa pioche de toutes ses forces", Multiplicateur: 160, Effet: "Armure brisée", Recharge: 3, Chance: 50}}},
+			},
+			TourEnrage:  12,
+			BonusEnrage: 15,
+			Butin:       "Casque du Contremaître",
+		}, true
+	case "Reine Kairis":
+		return Boss{
+			Introduction: "Qui ose troubler le nid de la Reine ?",
+			Phases: []Phase{
+				{SeuilPv: 70, Dialogue: "Mes enfants, à moi !",
+					Renforts: []Ennemi{{Nom: "Kairis", Pv: 80, Attaque: 30}, {Nom: "Kairis", Pv: 80, Attaque: 30}}},
+				{SeuilPv: 35, Dialogue: "Vous ne quitterez jamais ces profondeurs !", ModAttaque: 25,
+					Capacites: []Capacite{{Nom: "Nuée de dards", Description: "projette une nuée de dards venimeux", Multiplicateur: 130, Effet: "Poison", Recharge: 3, Chance: 50}}},
+			},
+			TourEnrage:  15,
+			BonusEnrage: 15,
+			Butin:       "Carapace de la Reine",
+		}, true
+	case "Milousque des Brumes":
+		return Boss{
+			Introduction: "La brume se rassemble en une silhouette ailée qui vous fixe sans ciller...",
+			Phases: []Phase{
+				{SeuilPv: 60, Dialogue: "Le Milousque se dissout dans la brume, d'où jaillissent des lueurs dansantes !",
+					Renforts:  []Ennemi{{Nom: "Feu follet", Pv: 60, Attaque: 30}},
+					Capacites: []Capacite{{Nom: "Voile de brume", Description: "s'enveloppe d'un voile de brume", Effet: "Esquive", Recharge: 4, Chance: 50}}},
+				{SeuilPv: 25, Dialogue: "Ses yeux s'embrasent d'une lueur furieuse.", ModAttaque: 30},
+			},
+			TourEnrage:  20,
+			BonusEnrage: 10,
+			Butin:       "Jambières des Brumes",
+		}, true
+	case "Grand Milousque":
+		return Boss{
+			Introduction: "Le Grand Milousque déploie ses ailes. L'île entière retient son souffle.",
+			Phases: []Phase{
+				{SeuilPv: 75, Dialogue: "Gardiens, protégez votre roi !",
+					Renforts: []Ennemi{{Nom: "Gardien Milousque", Pv: 120, Attaque: 45}}},
+				{SeuilPv: 50, Dialogue: "Le ciel s'assombrit, une tempête de plumes se lève !",
+					Capacites: []Capacite{{Nom: "Tempête de plumes", Description: "déchaîne une tempête de plumes tranchantes", Multiplicateur: 140, Effet: "Saignement", Recharge: 3, Chance: 50}}},
+				{SeuilPv: 20, Dialogue: "Le Grand Milousque pousse un rugissement de rage !", ModAttaque: 40},
+			},
+			TourEnrage:  25,
+			BonusEnrage: 10,
+			Butin:       "Croc du Grand Milousque",
+		}, true
+	default:
+		return Boss{}, false
+	}
+}
+
+// completerProfil donne à l'ennemi le profil de son espèce s'il n'en a pas déjà un
+func (e *Ennemi) completerProfil() {
+	if e.PvMax == 0 {
+		e.PvMax = e.Pv
+	}
+	if e.Capacites == nil {
+		e.Comportement, e.Capacites = GetCapacites(e.Nom)
+	}
+	profil := GetProfil(e.Nom)
+	if e.Vitesse == 0 {
+		e.Vitesse = profil.Vitesse
+		if e.Vitesse == 0 {
+			e.Vitesse = vitesseParDefaut
+		}
+	}
+	if e.Defense != 0 || e.Resistances != nil {
+		return
+	}
 	
-	for joueur.Pdv > 0 && ennemi.Pv > 0 && tourCount < maxTours {
-		tourCount++
-		
-		fmt.Printf("\n=== Tour %d ===\n", tourCount)
-		
-		ui.AfficherMenuCombat(
-			joueur.Nom, joueur.Pdv, joueur.Classe.Pvmax, joueur.Mana, joueur.Classe.ManaMax,
-			ennemi.Nom, ennemi.Pv, joueur.Classe.Sorts, joueur.Inventaire.Potions, joueur.Inventaire.PotionsMana,
-		)
+	e.Defense = profil.Defense
+	e.Resistances = profil.Resistances
+	if e.EffetAttaque == "" {
+		e.EffetAttaque = profil.EffetAttaque
+		e.ChanceEffet = profil.ChanceEffet
+	}
+}
 
-		// Créer les options disponibles
-		options := make([]string, 0)
-		
-		// Vérifier si le joueur a des sorts utilisables
-		sortUtilisable := false
-		for _, s := range joueur.Classe.Sorts {
-			options = append(options, fmt.Sprintf("%s (Dégâts: %d, Mana: %d)", s.Nom, s.Degats, s.Cout))
-			if joueur.Mana >= s.Cout {
-				sortUtilisable = true
+// DescriptionProfil résume la défense, la vitesse, les faiblesses, les résistances et le comportement de l'ennemi
+func (e *Ennemi) DescriptionProfil() string {
+	faiblesses := []string{}
+	resistances := []string{}
+	for element, valeur := range e.Resistances {
+		libelle := fmt.Sprintf("%s %s %+d%%", element.Emoji(), element, -valeur)
+		if valeur >= 100 {
+			libelle = fmt.Sprintf("%s %s (immunisé)", element.Emoji(), element)
+		}
+		if valeur < 0 {
+			faiblesses = append(faiblesses, libelle)
+		} else if valeur > 0 {
+			resistances = append(resistances, libelle)
+		}
+	}
+	sort.Strings(faiblesses)
+	sort.Strings(resistances)
+
+	description := fmt.Sprintf("🛡️  Défense %d | Vitesse %d", e.Defense, e.Vitesse)
+	if len(faiblesses) > 0 {
+		description += " | Faible : " + strings.Join(faiblesses, ", ")
+	}
+	if len(resistances) > 0 {
+		description += " | Résiste : " + strings.Join(resistances, ", ")
+	}
+	if len(e.Capacites) > 0 {
+		description += fmt.Sprintf(" | Comportement %s", e.Comportement)
+	}
+	return description
+}
+
+// calculerDegats applique la résistance de l'ennemi à l'élément, puis sa défense aux dégâts physiques
+// Retourne les dégâts finaux et le détail des modificateurs pour le journal de combat
+func (e *Ennemi) calculerDegats(base int, element sorts.Element) (int, []string) {
+	degats := base
+	details := []string{}
+
+	if resistance := e.Resistances[element]; resistance != 0 {
+		degats = degats * (100 - resistance) / 100
+		switch {
+		case resistance >= 100:
+			details = append(details, fmt.Sprintf("immunisé au %s", strings.ToLower(string(element))))
+		case resistance < 0:
+			details = append(details, fmt.Sprintf("faiblesse %s : +%d%%", strings.ToLower(string(element)), -resistance))
+		default:
+			details = append(details, fmt.Sprintf("résistance %s : -%d%%", strings.ToLower(string(element)), resistance))
+		}
+	}
+	if degats <= 0 {
+		return 0, details
+	}
+
+	defense := e.Defense + e.Effets.ModDefense()
+	if element == sorts.ElementPhysique && defense > 0 {
+		degats -= defense
+		details = append(details, fmt.Sprintf("défense : -%d", defense))
+	}
+	if degats < 1 {
+		degats = 1 // Minimum 1 dégât
+	}
+	return degats, details
+}
+
+// Effet représente un effet de statut actif sur un combattant
+type Effet struct {
+	Nom           string
+	Emoji         string
+	Duree         int  // Tours restants
+	DegatsParTour int  // Négatif = soin
+	PasseTour     bool // Le combattant perd son tour
+	ModAttaque    int  // Ajouté à l'attaque du combattant
+	ModDefense    int  // Ajouté à la défense du combattant
+	Esquive       bool // La prochaine attaque reçue est esquivée
+	Reduction     int  // Réduction (en %) des dégâts reçus
+	Benefique     bool // S'applique au lanceur plutôt qu'à sa cible
+	JusquAction   bool // Reste actif jusqu'à la prochaine action du combattant au lieu de s'écouler
+}
+
+// GetEffet retourne un effet de statut à partir de son nom
+func GetEffet(nom string) (Effet, bool) {
+	switch nom {
+	case "Poison":
+		return Effet{Nom: "Poison", Emoji: "🐍", Duree: 3, DegatsParTour: 8}, true
+	case "Brûlure":
+		return Effet{Nom: "Brûlure", Emoji: "🔥", Duree: 2, DegatsParTour: 12}, true
+	case "Saignement":
+		return Effet{Nom: "Saignement", Emoji: "💧", Duree: 4, DegatsParTour: 6}, true
+	case "Étourdissement":
+		return Effet{Nom: "Étourdissement", Emoji: "💫", Duree: 1, PasseTour: true}, true
+	case "Armure brisée":
+		return Effet{Nom: "Armure brisée", Emoji: "💔", Duree: 3, ModDefense: -8}, true
+	case "Carapace":
+		return Effet{Nom: "Carapace", Emoji: "🐚", Duree: 2, ModDefense: 25, Benefique: true}, true
+	case "Esquive":
+		return Effet{Nom: "Esquive", Emoji: "💨", Duree: 2, Esquive: true, Benefique: true}, true
+	case "Garde":
+		return Effet{Nom: "Garde", Emoji: "🛡️", Duree: 1, Reduction: reductionGarde, Benefique: true, JusquAction: true}, true
+	case "Bouclier":
+		return Effet{Nom: "Bouclier", Emoji: "🛡️", Duree: 3, ModDefense: 15, Benefique: true}, true
+	case "Régénération":
+		return Effet{Nom: "Régénération", Emoji: "💚", Duree: 3, DegatsParTour: -12, Benefique: true}, true
+	default:
+		return Effet{}, false
+	}
+}
+
+// Effets est la liste des effets actifs sur un combattant
+type Effets []Effet
+
+// Ajouter applique un effet ; s'il est déjà actif, sa durée est renouvelée
+func (l *Effets) Ajouter(e Effet) {
+	for i := range *l {
+		if (*l)[i].Nom == e.Nom {
+			(*l)[i].Duree = e.Duree
+			return
+		}
+	}
+	*l = append(*l, e)
+}
+
+// ModAttaque retourne le total des modificateurs d'attaque des effets actifs
+func (l Effets) ModAttaque() int {
+	total := 0
+	for _, e := range l {
+		total += e.ModAttaque
+	}
+	return total
+}
+
+// ModDefense retourne le total des modificateurs de défense des effets actifs
+func (l Effets) ModDefense() int {
+	total := 0
+	for _, e := range l {
+		total += e.ModDefense
+	}
+	return total
+}
+
+// Reduction retourne la réduction totale (en %) des dégâts reçus, plafonnée à 90%
+func (l Effets) Reduction() int {
+	total := 0
+	for _, e := range l {
+		total += e.Reduction
+	}
+	if total > 90 {
+		total = 90
+	}
+	return total
+}
+
+// esquiver consomme un effet d'esquive s'il y en a un actif et retourne son nom
+func (l *Effets) esquiver() (string, bool) {
+	for i, e := range *l {
+		if e.Esquive {
+			*l = append((*l)[:i], (*l)[i+1:]...)
+			return e.Nom, true
+		}
+	}
+	return "", false
+}
+
+// Actif indique si un effet est actif
+func (l Effets) Actif(nom string) bool {
+	for _, e := range l {
+		if e.Nom == nom {
+			return true
+		}
+	}
+	return false
+}
+
+// Description résume les effets actifs pour l'affichage du combat
+func (l Effets) Description() string {
+	descriptions := []string{}
+	for _, e := range l {
+		descriptions = append(descriptions, fmt.Sprintf("%s %s (%d)", e.Emoji, e.Nom, e.Duree))
+	}
+	return strings.Join(descriptions, ", ")
+}
+
+// appliquer déclenche les effets au début du tour d'un combattant, puis réduit leur durée
+// pvMax limite les soins (0 = pas de limite). Retourne vrai si le combattant perd son tour
+func (l *Effets) appliquer(nom string, pv *int, pvMax int) bool {
+	passeTour := false
+	restants := Effets{}
+
+	for _, e := range *l {
+		if e.JusquAction {
+			restants = append(restants, e)
+			continue
+		}
+		switch {
+		case e.DegatsParTour > 0:
+			*pv -= e.DegatsParTour
+			fmt.Printf("%s %s subit %d dégâts (%s) !\n", e.Emoji, nom, e.DegatsParTour, e.Nom)
+		case e.DegatsParTour < 0:
+			*pv -= e.DegatsParTour
+			if pvMax > 0 && *pv > pvMax {
+				*pv = pvMax
 			}
+			fmt.Printf("%s %s récupère %d PV (%s) !\n", e.Emoji, nom, -e.DegatsParTour, e.Nom)
 		}
-		
-		// Ajouter les options d'utilisation de potions et de fuite
-		options = append(options, fmt.Sprintf("Utiliser une potion de vie (+50 PV) (%d disponibles)", joueur.Inventaire.Potions))
-		options = append(options, fmt.Sprintf("Utiliser une potion de mana (+50 Mana) (%d disponibles)", joueur.Inventaire.PotionsMana))
-		options = append(options, "Fuir le combat")
-		
-		// Si aucun sort n'est utilisable et pas de potions, proposer la fuite
-		if !sortUtilisable && joueur.Inventaire.Potions <= 0 && joueur.Inventaire.PotionsMana <= 0 {
-			fmt.Println("\n⚠️  Plus de mana et pas de potions ! Vous devez fuir ou utiliser une attaque de base.")
+		if e.PasseTour {
+			passeTour = true
+			fmt.Printf("%s %s est étourdi et perd son tour !\n", e.Emoji, nom)
 		}
-		
-		choix := utils.ScanChoice("Choisis ton action : ", options)
 
-		optionPotionVie := len(joueur.Classe.Sorts) + 1
-		optionPotionMana := len(joueur.Classe.Sorts) + 2
-		optionFuite := len(joueur.Classe.Sorts) + 3
+		e.Duree--
+		if e.Duree > 0 {
+			restants = append(restants, e)
+		} else if !e.PasseTour {
+			fmt.Printf("✨ %s n'est plus affecté par %s.\n", nom, e.Nom)
+		}
+	}
 
-		if choix == optionFuite {
-			fmt.Println("\n🏃 Vous fuyez le combat !")
-			break
-		} else if choix == optionPotionVie {
-			if joueur.Inventaire.Potions > 0 {
-				anciensPV := joueur.Pdv
-				joueur.Pdv += 50
-				if joueur.Pdv > joueur.Classe.Pvmax {
-					joueur.Pdv = joueur.Classe.Pvmax
-				}
-				joueur.Inventaire.Potions--
-				pvRecuperes := joueur.Pdv - anciensPV
-				fmt.Printf("🧆 Vous utilisez une potion de vie et récupérez %d PV !\n", pvRecuperes)
-			} else {
-				fmt.Println("⚠️  Vous n'avez pas de potion de vie !")
-				continue
+	*l = restants
+	return passeTour
+}
+
+// retirerJusquAction retire les effets qui ne durent que jusqu'à la prochaine action du combattant
+func (l *Effets) retirerJusquAction() {
+	restants := Effets{}
+	for _, e := range *l {
+		if !e.JusquAction {
+			restants = append(restants, e)
+		}
+	}
+	*l = restants
+}
+
+// infligerEffet applique un effet nommé : au lanceur s'il est bénéfique, sinon à sa cible
+func infligerEffet(nomEffet string, lanceur, cible *Effets, nomLanceur, nomCible string) {
+	e, existe := GetEffet(nomEffet)
+	if !existe {
+		return
+	}
+	duree := fmt.Sprintf("%d tours", e.Duree)
+	if e.Duree == 1 {
+		duree = "1 tour"
+	}
+	if e.Benefique {
+		lanceur.Ajouter(e)
+		fmt.Printf("%s %s bénéficie de %s pendant %s !\n", e.Emoji, nomLanceur, e.Nom, duree)
+		return
+	}
+	cible.Ajouter(e)
+	fmt.Printf("%s %s subit %s pendant %s !\n", e.Emoji, nomCible, e.Nom, duree)
+}
+
+// Fight lance un combat sans influence de la météo
+func Fight(joueur *character.Character, ennemi *Ennemi) {
+	FightAvecMeteo(joueur, ennemi, meteo.GetMeteo("Ensoleillé"))
+}
+
+// Résultat de l'action choisie par le joueur pendant son tour
+const (
+	actionJouee = iota
+	actionRejouer // Action impossible : le joueur choisit à nouveau sans perdre son tour
+	actionFuite
+)
+
+// FightAvecMeteo lance un combat où la météo de la zone modifie l'attaque de l'ennemi
+func FightAvecMeteo(joueur *character.Character, ennemi *Ennemi, conditions meteo.Meteo) {
+	FightGroupe(joueur, []*Ennemi{ennemi}, conditions)
+}
+
+// FightGroupe lance un combat contre un groupe d'ennemis
+// Le joueur choisit sa cible, les sorts de zone touchent tout le groupe et chaque ennemi agit à son tour
+func FightGroupe(joueur *character.Character, ennemis []*Ennemi, conditions meteo.Meteo) {
+	if len(ennemis) > 1 {
+		fmt.Printf("⚠️  %d ennemis vous font face !\n", len(ennemis))
+	}
+	
+	for _, ennemi := range ennemis {
+		preparerEnnemi(joueur, ennemi, conditions)
+		if ennemi.boss != nil {
+			fmt.Printf("👑 %s : « %s »\n", ennemi.Nom, ennemi.boss.Introduction)
+			fmt.Printf("⏳ %s s'enragera au tour %d.\n", ennemi.Nom, ennemi.boss.TourEnrage)
+		}
+	}
+	effetsJoueur := Effets{}
+	vaincus := make([]bool, len(ennemis))
+	allies := alliesDuJoueur(joueur)
+	for _, a := range allies {
+		fmt.Printf("%s %s combat à vos côtés ! (PV %d/%d, Attaque %d)\n", a.emoji, a.nom, *a.pv, a.pvMax, a.attaque)
+	}
+	
+	tourCount := 0
+	nouveauTour := true
+	joueurEtourdi := false
+	
+	for joueur.Pdv > 0 && len(ennemisVivants(ennemis)) > 0 {
+		if nouveauTour {
+			tourCount++
+			nouveauTour = false
+			fmt.Printf("\n=== Tour %d ===\n", tourCount)
+			enrager(ennemis, tourCount)
+			
+			// Effets actifs sur le joueur au début de son tour
+			joueurEtourdi = effetsJoueur.appliquer(joueur.Nom, &joueur.Pdv, joueur.Classe.Pvmax)
+			if joueur.Pdv <= 0 {
+				break
 			}
-		} else if choix == optionPotionMana {
-			if joueur.Inventaire.PotionsMana > 0 {
-				ancienMana := joueur.Mana
-				joueur.Mana += 50
-				if joueur.Mana > joueur.Classe.ManaMax {
-					joueur.Mana = joueur.Classe.ManaMax
-				}
-				joueur.Inventaire.PotionsMana--
-				manaRecupere := joueur.Mana - ancienMana
-				fmt.Printf("🧙 Vous utilisez une potion de mana et récupérez %d Mana !\n", manaRecupere)
-			} else {
-				fmt.Println("⚠️  Vous n'avez pas de potion de mana !")
+			
+			// Les ennemis qui ont gagné l'initiative agissent avant le joueur
+			faireAgirEnnemis(joueur, ennemis, allies, &effetsJoueur, vaincus, true)
+			ennemis, vaincus = declencherPhases(joueur, ennemis, vaincus, conditions)
+			if joueur.Pdv <= 0 || len(ennemisVivants(ennemis)) == 0 {
+				break
+			}
+		}
+		
+		if !joueurEtourdi {
+			attaqueBase, _ := sortAttaqueBase(joueur)
+			ui.AfficherMenuCombat(
+				joueur.Nom, joueur.Pdv, joueur.Classe.Pvmax, joueur.Mana, joueur.Classe.ManaMax, effetsJoueur.Description(),
+				lignesEnnemis(ennemis), joueur.Classe.Sorts, attaqueBase.Nom, attaqueBase.Degats+joueur.CalculerAttaqueBonus(),
+				joueur.Inventaire.Potions, joueur.Inventaire.PotionsMana, len(objetsDeCombat(joueur)), chanceDeFuir(joueur, ennemis),
+			)
+			
+			resultat := tourJoueur(joueur, ennemis, &effetsJoueur)
+			if resultat == actionRejouer {
 				continue
 			}
-		} else if choix >= 1 && choix <= len(joueur.Classe.Sorts) {
-			s := joueur.Classe.Sorts[choix-1]
-			if joueur.Mana < s.Cout {
-				fmt.Println("⚠️  Pas assez de mana pour lancer ce sort !")
+			if resultat == actionFuite && (tenterFuite(joueur, ennemis, &effetsJoueur) || joueur.Pdv <= 0) {
+				break
+			}
+		}
+		faireAgirAllies(joueur, allies, ennemis, &effetsJoueur)
+		annoncerVaincus(ennemis, vaincus)
+		ennemis, vaincus = declencherPhases(joueur, ennemis, vaincus, conditions)
+		
+		faireAgirEnnemis(joueur, ennemis, allies, &effetsJoueur, vaincus, false)
+		ennemis, vaincus = declencherPhases(joueur, ennemis, vaincus, conditions)
+		if len(ennemisVivants(ennemis)) == 0 {
+			break
+		}
+		
+		nouveauTour = true
+		
+		// Petite pause pour la lisibilité
+		fmt.Println("\nAppuyez sur Entrée pour continuer...")
+		fmt.Scanln()
+	}
+	
+	if joueur.Pdv > 0 {
+		for _, ennemi := range ennemis {
+			// Un ennemi apprivoisé rejoint le joueur : ni XP, ni quête, ni butin
+			if ennemi.Pv > 0 || ennemi.apprivoise {
 				continue
 			}
-			joueur.Mana -= s.Cout
 			
-			// Appliquer bonus d'attaque de l'équipement
-			bonusAttaque := joueur.CalculerAttaqueBonus()
-			degatsFinaux := s.Degats + bonusAttaque
-			ennemi.Pv -= degatsFinaux
+			// Mettre à jour le progrès des quêtes après victoire
+			joueur.MettreAJourProgresQuete(ennemi.Nom)
 			
-			if bonusAttaque > 0 {
-				fmt.Printf("⚔️  Tu lances %s et infliges %d dégâts (%d base + %d bonus équipement) !\n", s.Nom, degatsFinaux, s.Degats, bonusAttaque)
-			} else {
-				fmt.Printf("⚔️  Tu lances %s et infliges %d dégâts !\n", s.Nom, degatsFinaux)
+			// Gagner XP après victoire, selon les PV max de l'ennemi
+			xpGagne := 25 + ennemi.PvMax/2
+			joueur.GagnerExperience(xpGagne)
+			if compagnon := joueur.CompagnonActif(); compagnon != nil {
+				compagnon.GagnerExperience(xpGagne / 2)
 			}
-		} else {
-			fmt.Println("⚠️  Choix invalide, vous perdez votre tour !")
+			
+			// Butin unique d'un boss vaincu pour la première fois
+			if ennemi.boss != nil && ennemi.boss.Butin != "" && !joueur.AVaincuBoss(ennemi.Nom) {
+				joueur.EnregistrerBossVaincu(ennemi.Nom)
+				if joueur.Inventaire.AddItem(item.NewItem(ennemi.boss.Butin), 1) {
+					fmt.Printf("🎁 %s laisse tomber un butin unique : %s !\n", ennemi.Nom, ennemi.boss.Butin)
+				}
+			}
+		}
+	}
+	
+	// Les ennemis apprivoisés ne rejoignent les compagnons qu'une fois le combat terminé :
+	// le compagnon actif combat à travers un pointeur sur joueur.Compagnons, qu'un ajout déplacerait
+	for _, ennemi := range ennemis {
+		if ennemi.apprivoise {
+			joueur.AjouterCompagnon(ennemi.compagnon)
+		}
+	}
+	
+	if joueur.Pdv <= 0 {
+		fmt.Println("💀 Tu as été vaincu... Game Over.")
+	} else if len(ennemisVivants(ennemis)) > 0 {
+		fmt.Println("🏃 Vous avez fui le combat avec succès !")
+	}
+}
+
+// preparerEnnemi prépare un ennemi qui entre dans le combat : profil, météo, initiative et état de combat
+func preparerEnnemi(joueur *character.Character, ennemi *Ennemi, conditions meteo.Meteo) {
+	agilite, _ := joueur.Classe.Statistiques()
+	ennemi.completerProfil()
+	fmt.Printf("🔎 %s : %s\n", ennemi.Nom, ennemi.DescriptionProfil())
+	
+	ennemi.attaqueCombat = conditions.AppliquerAttaque(ennemi.Attaque)
+	if ennemi.attaqueCombat != ennemi.Attaque {
+		fmt.Printf("%s %s : %s attaque avec %d au lieu de %d !\n",
+			conditions.Emoji, conditions.Nom, ennemi.Nom, ennemi.attaqueCombat, ennemi.Attaque)
+	}
+	
+	// Initiative : agilité du joueur contre vitesse de l'ennemi, chacun avec un jet de 1 à 10
+	jetJoueur := agilite + 1 + rand.Intn(10)
+	jetEnnemi := ennemi.Vitesse + 1 + rand.Intn(10)
+	ennemi.agitAvant = jetEnnemi > jetJoueur
+	if ennemi.agitAvant {
+		fmt.Printf("⏱️  Initiative : %s %d contre %s %d, il agira avant vous !\n", ennemi.Nom, jetEnnemi, joueur.Nom, jetJoueur)
+	} else {
+		fmt.Printf("⏱️  Initiative : %s %d contre %s %d, vous agirez en premier.\n", joueur.Nom, jetJoueur, ennemi.Nom, jetEnnemi)
+	}
+	
+	// Les effets de statut et les recharges ne durent que le temps du combat
+	ennemi.Effets = Effets{}
+	ennemi.recharges = map[string]int{}
+	ennemi.enrage = false
+	ennemi.coupGratuit = false
+	ennemi.boss = nil
+	ennemi.phase = 0
+	if boss, existe := GetBoss(ennemi.Nom); existe {
+		ennemi.boss = &boss
+		ennemi.Capacites = append([]Capacite{}, ennemi.Capacites...) // Les phases ne modifient pas les capacités de l'espèce
+	}
+}
+
+// declencherPhases déclenche les phases des boss dont les PV sont passés sous un seuil
+// Retourne le groupe et l'état des vaincus, agrandis des renforts invoqués
+func declencherPhases(joueur *character.Character, ennemis []*Ennemi, vaincus []bool, conditions meteo.Meteo) ([]*Ennemi, []bool) {
+	for _, ennemi := range ennemis {
+		if ennemi.boss == nil || ennemi.Pv <= 0 {
+			continue
+		}
+		for ennemi.phase < len(ennemi.boss.Phases) && ennemi.Pv*100 <= ennemi.PvMax*ennemi.boss.Phases[ennemi.phase].SeuilPv {
+			phase := ennemi.boss.Phases[ennemi.phase]
+			ennemi.phase++
+			fmt.Printf("\n👑 === %s : phase %d === 👑\n", ennemi.Nom, ennemi.phase+1)
+			fmt.Printf("%s : « %s »\n", ennemi.Nom, phase.Dialogue)
+			
+			if phase.ModAttaque > 0 {
+				bonus := ennemi.Attaque * phase.ModAttaque / 100
+				ennemi.attaqueCombat += bonus
+				fmt.Printf("📈 L'attaque de %s augmente de %d !\n", ennemi.Nom, bonus)
+			}
+			for _, c := range phase.Capacites {
+				ennemi.Capacites = append(ennemi.Capacites, c)
+				fmt.Printf("✨ %s maîtrise désormais : %s\n", ennemi.Nom, c.Nom)
+			}
+			for _, renfort := range phase.Renforts {
+				invoque := renfort
+				fmt.Printf("📣 %s appelle %s à la rescousse !\n", ennemi.Nom, invoque.Nom)
+				preparerEnnemi(joueur, &invoque, conditions)
+				ennemis = append(ennemis, &invoque)
+				vaincus = append(vaincus, false)
+			}
+		}
+	}
+	return ennemis, vaincus
+}
+
+// enrager augmente l'attaque des ennemis encore debout dont le combat s'éternise
+// Les boss s'enragent à leur propre tour, les autres ennemis à tourEnrageParDefaut
+func enrager(ennemis []*Ennemi, tour int) {
+	for _, ennemi := range ennemisVivants(ennemis) {
+		tourEnrage, bonusEnrage := tourEnrageParDefaut, bonusEnrageParDefaut
+		if ennemi.boss != nil {
+			tourEnrage, bonusEnrage = ennemi.boss.TourEnrage, ennemi.boss.BonusEnrage
+		}
+		if tour < tourEnrage {
+			continue
 		}
+		if !ennemi.enrage {
+			ennemi.enrage = true
+			fmt.Printf("💢 %s perd patience et entre dans une rage folle !\n", ennemi.Nom)
+		}
+		bonus := ennemi.Attaque * bonusEnrage / 100
+		if bonus < 1 {
+			bonus = 1
+		}
+		ennemi.attaqueCombat += bonus
+		fmt.Printf("💢 %s est enragé : attaque %d (+%d)\n", ennemi.Nom, ennemi.attaqueCombat, bonus)
+	}
+}
 
+// faireAgirEnnemis fait agir, après leurs effets actifs, les ennemis encore debout de la phase demandée
+// avant indique la phase des ennemis qui ont gagné l'initiative
+func faireAgirEnnemis(joueur *character.Character, ennemis []*Ennemi, allies []*allie, effetsJoueur *Effets, vaincus []bool, avant bool) {
+	for _, ennemi := range ennemis {
+		if ennemi.agitAvant != avant || ennemi.Pv <= 0 || joueur.Pdv <= 0 {
+			continue
+		}
+		ennemiEtourdi := ennemi.Effets.appliquer(ennemi.Nom, &ennemi.Pv, ennemi.PvMax)
 		if ennemi.Pv <= 0 {
-			fmt.Printf("🏆 %s est vaincu !\n", ennemi.Nom)
-			break
+			annoncerVaincus(ennemis, vaincus)
+			continue
+		}
+		if !ennemiEtourdi && !ennemi.coupGratuit {
+			tourEnnemi(joueur, ennemi, allies, effetsJoueur)
 		}
+		ennemi.coupGratuit = false
+	}
+}
+
+// chanceDeFuir calcule la chance (en %) d'échapper au combat selon l'écart entre l'agilité du joueur et la vitesse de l'ennemi le plus rapide
+func chanceDeFuir(joueur *character.Character, ennemis []*Ennemi) int {
+	agilite, _ := joueur.Classe.Statistiques()
+	chance := fuiteBase
+	if plusRapide := ennemiLePlusRapide(ennemis); plusRapide != nil {
+		chance += (agilite - plusRapide.Vitesse) * fuiteParPoint
+	}
+	if chance < fuiteMin {
+		chance = fuiteMin
+	}
+	if chance > fuiteMax {
+		chance = fuiteMax
+	}
+	return chance
+}
 
-		// Appliquer bonus de défense
-		bonusDefense := joueur.CalculerDefenseBonus()
-		degatsSubis := ennemi.Attaque - bonusDefense
-		if degatsSubis < 1 {
-			degatsSubis = 1 // Minimum 1 dégât
+// ennemiLePlusRapide retourne l'ennemi encore debout qui a la plus grande vitesse (nil s'il n'y en a plus)
+func ennemiLePlusRapide(ennemis []*Ennemi) *Ennemi {
+	var plusRapide *Ennemi
+	for _, ennemi := range ennemisVivants(ennemis) {
+		if plusRapide == nil || ennemi.Vitesse > plusRapide.Vitesse {
+			plusRapide = ennemi
 		}
-		
-		joueur.Pdv -= degatsSubis
-		
-		if bonusDefense > 0 {
-			fmt.Printf("🔴 %s t'attaque ! Tu subis %d dégâts (%d - %d défense) !\n", ennemi.Nom, degatsSubis, ennemi.Attaque, bonusDefense)
+	}
+	return plusRapide
+}
+
+// tenterFuite lance le jet de fuite du joueur et retourne vrai s'il quitte le combat
+// En cas d'échec, l'ennemi le plus rapide frappe gratuitement à la place de son tour normal (s'il ne l'a pas déjà joué grâce à l'initiative)
+// En cas de réussite, le joueur peut perdre de l'or dans sa course
+func tenterFuite(joueur *character.Character, ennemis []*Ennemi, effetsJoueur *Effets) bool {
+	chance := chanceDeFuir(joueur, ennemis)
+	if rand.Intn(100) >= chance {
+		plusRapide := ennemiLePlusRapide(ennemis)
+		fmt.Printf("\n🚫 Vous tentez de fuir, mais %s vous barre la route ! (%d%% de réussite)\n", plusRapide.Nom, chance)
+		frapperJoueur(joueur, plusRapide, plusRapide.attaqueCombat+plusRapide.Effets.ModAttaque(), effetsJoueur)
+		plusRapide.coupGratuit = !plusRapide.agitAvant
+		return false
+	}
+	
+	if joueur.Argent > 0 && rand.Intn(100) < chancePerteOr {
+		perte := joueur.Argent * perteOr / 100
+		if perte < 1 {
+			perte = 1
+		}
+		joueur.Argent -= perte
+		fmt.Printf("💸 Dans votre course, vous laissez tomber %d pièces d'or !\n", perte)
+	}
+	return true
+}
+
+// Regenerer rend à un ennemi blessé une part de ses PV max pour chaque heure de jeu écoulée
+// Retourne les PV regagnés
+func (e *Ennemi) Regenerer(heures int) int {
+	if heures <= 0 || e.PvMax <= 0 || e.Pv <= 0 || e.Pv >= e.PvMax {
+		return 0
+	}
+	soin := e.PvMax * regenerationParHeure * heures / 100
+	if soin < 1 {
+		soin = 1
+	}
+	if e.Pv+soin > e.PvMax {
+		soin = e.PvMax - e.Pv
+	}
+	e.Pv += soin
+	return soin
+}
+
+// EstApprivoise indique si l'ennemi a quitté le combat apprivoisé plutôt que vaincu
+func (e *Ennemi) EstApprivoise() bool {
+	return e.apprivoise
+}
+
+// chanceDeToucher calcule la chance (en %) qu'une attaque touche selon l'écart entre l'agilité de l'attaquant et celle de sa cible
+func chanceDeToucher(agiliteAttaquant, agiliteCible int) int {
+	chance := precisionBase + (agiliteAttaquant-agiliteCible)*precisionParPoint
+	if chance < precisionMin {
+		chance = precisionMin
+	}
+	if chance > precisionMax {
+		chance = precisionMax
+	}
+	return chance
+}
+
+// critiqueClasse retourne le multiplicateur (en %) et le nom du coup critique propre à une classe
+func critiqueClasse(nom string) (int, string) {
+	switch nom {
+	case "Voleur":
+		return 200, "Coup dans le dos"
+	case "Guerrier":
+		return 175, "Coup dévastateur"
+	case "Mage":
+		return multiplicateurCrit, "Surcharge arcanique"
+	default:
+		return multiplicateurCrit, "Coup critique"
+	}
+}
+
+// ennemisVivants retourne les ennemis encore debout
+func ennemisVivants(ennemis []*Ennemi) []*Ennemi {
+	vivants := []*Ennemi{}
+	for _, ennemi := range ennemis {
+		if ennemi.Pv > 0 {
+			vivants = append(vivants, ennemi)
+		}
+	}
+	return vivants
+}
+
+// annoncerVaincus annonce une seule fois chaque ennemi qui vient de tomber
+func annoncerVaincus(ennemis []*Ennemi, vaincus []bool) {
+	for i, ennemi := range ennemis {
+		if ennemi.Pv <= 0 && !vaincus[i] {
+			vaincus[i] = true
+			if ennemi.apprivoise {
+				fmt.Printf("🐾 %s est apprivoisé !\n", ennemi.Nom)
+			} else {
+				fmt.Printf("🏆 %s est vaincu !\n", ennemi.Nom)
+			}
+		}
+	}
+}
+
+// lignesEnnemis prépare l'affichage des ennemis encore debout et de leurs effets pour le menu de combat
+func lignesEnnemis(ennemis []*Ennemi) []string {
+	lignes := []string{}
+	for _, ennemi := range ennemisVivants(ennemis) {
+		lignes = append(lignes, fmt.Sprintf("Ennemi %s : PV %d", ennemi.Nom, ennemi.Pv))
+		if effets := ennemi.Effets.Description(); effets != "" {
+			lignes = append(lignes, "  Effets : "+effets)
+		}
+	}
+	return lignes
+}
+
+// choisirCible demande au joueur quel ennemi viser (nil s'il annule)
+// S'il ne reste qu'un ennemi, il est choisi directement
+func choisirCible(ennemis []*Ennemi) *Ennemi {
+	vivants := ennemisVivants(ennemis)
+	if len(vivants) == 1 {
+		return vivants[0]
+	}
+	
+	options := []string{}
+	for _, ennemi := range vivants {
+		option := fmt.Sprintf("%s (PV: %d/%d)", ennemi.Nom, ennemi.Pv, ennemi.PvMax)
+		if effets := ennemi.Effets.Description(); effets != "" {
+			option += " " + effets
+		}
+		options = append(options, option)
+	}
+	options = append(options, "Annuler")
+	
+	ui.AfficherMenu("Choisir une cible", options)
+	choix := utils.ScanChoice("Quel ennemi viser ? ", options)
+	if choix < 1 || choix > len(vivants) {
+		return nil
+	}
+	return vivants[choix-1]
+}
+
+// tourJoueur propose les actions du joueur et applique celle qu'il choisit
+func tourJoueur(joueur *character.Character, ennemis []*Ennemi, effetsJoueur *Effets) int {
+	// La garde protège jusqu'à la prochaine action du joueur, initiative ennemie comprise
+	effetsJoueur.retirerJusquAction()
+
+	// Créer les options disponibles
+	options := make([]string, 0)
+	
+	// Vérifier si le joueur a des sorts utilisables
+	sortUtilisable := false
+	for _, s := range joueur.Classe.Sorts {
+		options = append(options, fmt.Sprintf("%s %s (Dégâts: %d, Mana: %d)", s.TypeDegats().Emoji(), s.Nom, s.Degats, s.Cout))
+		if joueur.Mana >= s.Cout {
+			sortUtilisable = true
+		}
+	}
+	
+	// Ajouter les actions sans mana, puis les options d'utilisation de potions, d'objets et de fuite
+	attaqueBase, actionAttaque := sortAttaqueBase(joueur)
+	options = append(options, fmt.Sprintf("Attaque de base : %s (Dégâts: %d, sans mana)", attaqueBase.Nom, attaqueBase.Degats+joueur.CalculerAttaqueBonus()))
+	options = append(options, fmt.Sprintf("Se défendre (dégâts reçus -%d%%, +%d Mana)", reductionGarde, manaGarde))
+	objets := objetsDeCombat(joueur)
+	options = append(options, fmt.Sprintf("Utiliser une potion de vie (+50 PV) (%d disponibles)", joueur.Inventaire.Potions))
+	options = append(options, fmt.Sprintf("Utiliser une potion de mana (+50 Mana) (%d disponibles)", joueur.Inventaire.PotionsMana))
+	options = append(options, fmt.Sprintf("Utiliser un objet de combat (%d disponibles)", len(objets)))
+	options = append(options, fmt.Sprintf("Fuir le combat (%d%% de réussite)", chanceDeFuir(joueur, ennemis)))
+	
+	// Si aucun sort n'est utilisable et pas de potions, proposer la fuite
+	if !sortUtilisable && joueur.Inventaire.Potions <= 0 && joueur.Inventaire.PotionsMana <= 0 {
+		fmt.Println("\n⚠️  Plus de mana et pas de potions ! Il vous reste l'attaque de base, la garde ou la fuite.")
+	}
+	
+	choix := utils.ScanChoice("Choisis ton action : ", options)
+
+	optionAttaque := len(joueur.Classe.Sorts) + 1
+	optionDefense := len(joueur.Classe.Sorts) + 2
+	optionPotionVie := len(joueur.Classe.Sorts) + 3
+	optionPotionMana := len(joueur.Classe.Sorts) + 4
+	optionObjet := len(joueur.Classe.Sorts) + 5
+	optionFuite := len(joueur.Classe.Sorts) + 6
+
+	if choix == optionFuite {
+		return actionFuite
+	} else if choix == optionAttaque {
+		cible := choisirCible(ennemis)
+		if cible == nil {
+			return actionRejouer
+		}
+		lancerSort(joueur, attaqueBase, actionAttaque, cible, effetsJoueur)
+	} else if choix == optionDefense {
+		garde, _ := GetEffet("Garde")
+		effetsJoueur.Ajouter(garde)
+		ancienMana := joueur.Mana
+		joueur.Mana += manaGarde
+		if joueur.Mana > joueur.Classe.ManaMax {
+			joueur.Mana = joueur.Classe.ManaMax
+		}
+		fmt.Printf("🛡️  Vous vous mettez en garde : dégâts reçus -%d%% jusqu'à votre prochain tour, +%d Mana.\n", reductionGarde, joueur.Mana-ancienMana)
+	} else if choix == optionPotionVie {
+		if joueur.Inventaire.Potions > 0 {
+			anciensPV := joueur.Pdv
+			joueur.Pdv += 50
+			if joueur.Pdv > joueur.Classe.Pvmax {
+				joueur.Pdv = joueur.Classe.Pvmax
+			}
+			joueur.Inventaire.Potions--
+			pvRecuperes := joueur.Pdv - anciensPV
+			fmt.Printf("🧆 Vous utilisez une potion de vie et récupérez %d PV !\n", pvRecuperes)
+		} else {
+			fmt.Println("⚠️  Vous n'avez pas de potion de vie !")
+			return actionRejouer
+		}
+	} else if choix == optionPotionMana {
+		if joueur.Inventaire.PotionsMana > 0 {
+			ancienMana := joueur.Mana
+			joueur.Mana += 50
+			if joueur.Mana > joueur.Classe.ManaMax {
+				joueur.Mana = joueur.Classe.ManaMax
+			}
+			joueur.Inventaire.PotionsMana--
+			manaRecupere := joueur.Mana - ancienMana
+			fmt.Printf("🧙 Vous utilisez une potion de mana et récupérez %d Mana !\n", manaRecupere)
 		} else {
-			fmt.Printf("🔴 %s t'attaque et inflige %d dégâts !\n", ennemi.Nom, degatsSubis)
+			fmt.Println("⚠️  Vous n'avez pas de potion de mana !")
+			return actionRejouer
+		}
+	} else if choix == optionObjet {
+		return utiliserObjetDeCombat(joueur, ennemis, effetsJoueur, objets)
+	} else if choix >= 1 && choix <= len(joueur.Classe.Sorts) {
+		s := joueur.Classe.Sorts[choix-1]
+		if joueur.Mana < s.Cout {
+			fmt.Println("⚠️  Pas assez de mana pour lancer ce sort !")
+			return actionRejouer
 		}
 		
-		// Petite pause pour la lisibilité
-		fmt.Println("\nAppuyez sur Entrée pour continuer...")
-		fmt.Scanln()
+		// Un sort de zone touche tout le groupe, sinon le joueur choisit sa cible
+		cibles := ennemisVivants(ennemis)
+		if !s.EstDeZone() {
+			cible := choisirCible(ennemis)
+			if cible == nil {
+				return actionRejouer
+			}
+			cibles = []*Ennemi{cible}
+		} else if len(cibles) > 1 {
+			fmt.Printf("💥 %s frappe tous les ennemis !\n", s.Nom)
+		}
+		
+		joueur.Mana -= s.Cout
+		for _, cible := range cibles {
+			lancerSort(joueur, s, "Tu lances "+s.Nom, cible, effetsJoueur)
+		}
+	} else {
+		fmt.Println("⚠️  Choix invalide, vous perdez votre tour !")
+	}
+	return actionJouee
+}
+
+// sortAttaqueBase décrit l'attaque de base du joueur comme un sort physique gratuit, avec sa description pour le journal
+// Ses dégâts augmentent avec l'attaque de l'arme équipée, ajoutée comme bonus d'équipement
+func sortAttaqueBase(joueur *character.Character) (sorts.Sorts, string) {
+	if joueur.ArmeEquipee != nil {
+		return sorts.Sorts{Nom: joueur.ArmeEquipee.Nom, Degats: degatsAttaqueBase, Element: sorts.ElementPhysique},
+			"Tu frappes avec " + joueur.ArmeEquipee.Nom
+	}
+	return sorts.Sorts{Nom: "Coup de poing", Degats: degatsAttaqueBase, Element: sorts.ElementPhysique}, "Tu donnes un coup de poing"
+}
+
+// lancerSort applique un sort du joueur à une cible : esquive, dégâts modifiés puis effet secondaire
+// action décrit le geste du joueur dans le journal de combat
+func lancerSort(joueur *character.Character, s sorts.Sorts, action string, ennemi *Ennemi, effetsJoueur *Effets) {
+	if nomEsquive, esquive := ennemi.Effets.esquiver(); esquive {
+		fmt.Printf("💨 %s, mais %s l'esquive (%s) !\n", action, ennemi.Nom, nomEsquive)
+		return
 	}
 	
-	// Vérifier si le combat s'est arrêté à cause de la limite de tours
-	if tourCount >= maxTours {
-		fmt.Printf("\n⚠️  Combat trop long (%d tours) ! Arrêt automatique.\n", maxTours)
-		fmt.Println("Le combat se termine par un match nul...")
+	// Jet de précision : agilité du joueur contre vitesse de l'ennemi
+	agilite, chanceCritique := joueur.Classe.Statistiques()
+	precision := chanceDeToucher(agilite, ennemi.Vitesse)
+	if rand.Intn(100) >= precision {
+		fmt.Printf("💨 %s, mais %s l'esquive ! (%d%% de chances de toucher)\n", action, ennemi.Nom, precision)
 		return
 	}
+	
+	// Appliquer bonus d'attaque de l'équipement, le coup critique, puis les résistances et la défense de l'ennemi
+	bonusAttaque := joueur.CalculerAttaqueBonus()
+	bonusEffets := effetsJoueur.ModAttaque()
+	degatsBruts := s.Degats + bonusAttaque + bonusEffets
+	critique := rand.Intn(100) < chanceCritique
+	multiplicateur, nomCritique := critiqueClasse(joueur.Classe.Nom)
+	if critique {
+		degatsBruts = degatsBruts * multiplicateur / 100
+		fmt.Printf("💥 %s ! (%d%% de chances de critique)\n", nomCritique, chanceCritique)
+	}
+	element := s.TypeDegats()
+	degatsFinaux, details := ennemi.calculerDegats(degatsBruts, element)
+	ennemi.Pv -= degatsFinaux
+	
+	calcul := fmt.Sprintf("%d base", s.Degats)
+	if bonusAttaque > 0 {
+		calcul += fmt.Sprintf(" + %d bonus équipement", bonusAttaque)
+	}
+	if bonusEffets != 0 {
+		calcul += fmt.Sprintf(" %+d effets", bonusEffets)
+	}
+	if critique {
+		calcul += fmt.Sprintf(", critique x%d%%", multiplicateur)
+	}
+	if len(details) > 0 {
+		calcul += ", " + strings.Join(details, ", ")
+	}
+	fmt.Printf("⚔️  %s (%s %s) sur %s et infliges %d dégâts (%s) !\n", action, element.Emoji(), element, ennemi.Nom, degatsFinaux, calcul)
+	
+	// Effet secondaire du sort
+	if effet, chance := s.EffetSecondaire(); effet != "" && ennemi.Pv > 0 && rand.Intn(100) < chance {
+		infligerEffet(effet, effetsJoueur, &ennemi.Effets, joueur.Nom, ennemi.Nom)
+	}
+}
 
-	if joueur.Pdv > 0 && ennemi.Pv <= 0 {
+// objetsDeCombat retourne les noms des consommables de combat de l'inventaire, sans doublon
+func objetsDeCombat(joueur *character.Character) []string {
+	noms := []string{}
+	dejaVus := map[string]bool{}
+	for _, it := range joueur.Inventaire.Items {
+		if it.Type == item.TypeConsommable && it.EffetCombat != "" && !dejaVus[it.Nom] {
+			dejaVus[it.Nom] = true
+			noms = append(noms, it.Nom)
+		}
+	}
+	return noms
+}
+
+// utiliserObjetDeCombat fait choisir un consommable et applique son effet de statut
+func utiliserObjetDeCombat(joueur *character.Character, ennemis []*Ennemi, effetsJoueur *Effets, objets []string) int {
+	if len(objets) == 0 {
+		fmt.Println("⚠️  Vous n'avez aucun objet de combat !")
+		return actionRejouer
+	}
+	
+	options := []string{}
+	for _, nom := range objets {
+		it := item.NewItem(nom)
+		options = append(options, fmt.Sprintf("%s x%d - %s", nom, joueur.Inventaire.CompterItem(nom), it.Effet))
+	}
+	options = append(options, "Annuler")
+	
+	ui.AfficherMenu("Objets de combat", options)
+	choix := utils.ScanChoice("Quel objet utiliser ? ", options)
+	if choix < 1 || choix > len(objets) {
+		return actionRejouer
+	}
+	
+	it := item.NewItem(objets[choix-1])
+	if it.EffetCombat == effetApprivoisement {
+		return apprivoiser(joueur, ennemis, it.Nom)
+	}
+	
+	// Un objet néfaste doit viser un ennemi
+	cible := ennemisVivants(ennemis)[0]
+	if effet, _ := GetEffet(it.EffetCombat); !effet.Benefique {
+		if cible = choisirCible(ennemis); cible == nil {
+			return actionRejouer
+		}
+	}
+	
+	joueur.Inventaire.RetirerItem(it.Nom, 1)
+	fmt.Printf("🎒 Vous utilisez : %s\n", it.Nom)
+	infligerEffet(it.EffetCombat, effetsJoueur, &cible.Effets, joueur.Nom, cible.Nom)
+	return actionJouee
+}
+
+// apprivoiser tente d'apprivoiser un Milousque affaibli (hors boss) avec un objet d'apprivoisement
+// Apprivoisé, il quitte le combat et rejoint les compagnons du joueur
+func apprivoiser(joueur *character.Character, ennemis []*Ennemi, nomObjet string) int {
+	cible := choisirCible(ennemis)
+	if cible == nil {
+		return actionRejouer
+	}
+	if !strings.Contains(cible.Nom, "Milousque") {
+		fmt.Printf("⚠️  %s ne peut pas être apprivoisé, seuls les Milousques répondent au collier.\n", cible.Nom)
+		return actionRejouer
+	}
+	if cible.boss != nil {
+		fmt.Printf("⚠️  %s est bien trop puissant pour accepter un collier : il faut le vaincre.\n", cible.Nom)
+		return actionRejouer
+	}
+	pourcentagePv := cible.Pv * 100 / cible.PvMax
+	if pourcentagePv > seuilApprivoisement {
+		fmt.Printf("⚠️  %s est encore trop vigoureux (PV %d%%) : affaiblissez-le sous %d%% de ses PV.\n", cible.Nom, pourcentagePv, seuilApprivoisement)
+		return actionRejouer
+	}
+	
+	joueur.Inventaire.RetirerItem(nomObjet, 1)
+	chance := chanceApprivoisement + (seuilApprivoisement-pourcentagePv)*apprivoisementParPoint
+	if rand.Intn(100) >= chance {
+		fmt.Printf("💢 Vous passez le %s au cou de %s, mais il le brise d'un coup de tête ! (%d%% de chances)\n", nomObjet, cible.Nom, chance)
+		return actionJouee
+	}
+	
+	fmt.Printf("✨ %s baisse la tête et accepte le %s ! (%d%% de chances)\n", cible.Nom, nomObjet, chance)
+	nom := utils.ScanString(fmt.Sprintf("Comment voulez-vous appeler votre %s ? ", cible.Nom), 1)
+	pvMax := cible.PvMax / 3
+	cible.compagnon = character.Compagnon{
+		Nom:     nom,
+		Espece:  cible.Nom,
+		Niveau:  1,
+		Pv:      pvMax,
+		PvMax:   pvMax,
+		Attaque: cible.Attaque / 2,
+		Vitesse: cible.Vitesse,
+	}
+	fmt.Printf("🐾 %s rejoindra vos compagnons à la fin du combat !\n", nom)
+	
+	cible.Pv = 0
+	cible.apprivoise = true
+	return actionJouee
+}
+
+// GetCapacitesCompagnon retourne les capacités qu'un compagnon de cette espèce maîtrise à son niveau
+// Une capacité de soutien est débloquée au niveau 3
+func GetCapacitesCompagnon(espece string, niveau int) []Capacite {
+	capacites := []Capacite{}
+	switch espece {
+	case "Gardien Milousque":
+		capacites = append(capacites, Capacite{Nom: "Coup de queue", Description: "balaie l'ennemi d'un coup de queue", Multiplicateur: 140, Recharge: 2, Chance: 50})
+		if niveau >= 3 {
+			capacites = append(capacites, Capacite{Nom: "Rempart", Description: "se dresse devant vous pour vous protéger", Effet: "Bouclier", Recharge: 4, Chance: 40})
+		}
+	}
+	return capacites
+}
+
+// GetCapacitesMercenaire retourne les capacités d'un mercenaire selon son rôle
+func GetCapacitesMercenaire(role string) []Capacite {
+	switch role {
+	case "Épéiste":
+		return []Capacite{
+			{Nom: "Frappe lourde", Description: "abat son épée à deux mains", Multiplicateur: 150, Effet: "Armure brisée", Recharge: 3, Chance: 50},
+		}
+	case "Archère":
+		return []Capacite{
+			{Nom: "Flèche empoisonnée", Description: "décoche une flèche trempée dans le venin", Multiplicateur: 110, Effet: "Poison", Recharge: 3, Chance: 50},
+		}
+	case "Guérisseur":
+		return []Capacite{
+			{Nom: "Imposition des mains", Description: "pose les mains sur vos blessures", Soin: 25, SeuilPv: 60, Recharge: 3, Chance: 80},
+			{Nom: "Bénédiction", Description: "murmure une prière de guérison", Effet: "Régénération", Recharge: 5, Chance: 30},
+		}
+	default:
+		return []Capacite{}
+	}
+}
+
+// alliesDuJoueur retourne les alliés qui combattent aux côtés du joueur : son compagnon actif et ses mercenaires debout
+func alliesDuJoueur(joueur *character.Character) []*allie {
+	allies := []*allie{}
+	if compagnon := joueur.CompagnonActif(); compagnon != nil {
+		allies = append(allies, &allie{
+			nom:       compagnon.Nom,
+			emoji:     "🐾",
+			pv:        &compagnon.Pv,
+			pvMax:     compagnon.PvMax,
+			attaque:   compagnon.Attaque,
+			vitesse:   compagnon.Vitesse,
+			capacites: GetCapacitesCompagnon(compagnon.Espece, compagnon.Niveau),
+			recharges: map[string]int{},
+		})
+	}
+	for i := range joueur.Mercenaires {
+		mercenaire := &joueur.Mercenaires[i]
+		if mercenaire.Pv <= 0 {
+			continue
+		}
+		allies = append(allies, &allie{
+			nom:       mercenaire.Nom,
+			emoji:     "🗡️",
+			pv:        &mercenaire.Pv,
+			pvMax:     mercenaire.PvMax,
+			attaque:   mercenaire.Attaque,
+			vitesse:   mercenaire.Vitesse,
+			capacites: GetCapacitesMercenaire(mercenaire.Role),
+			recharges: map[string]int{},
+		})
+	}
+	return allies
+}
+
+// faireAgirAllies fait agir les alliés encore debout : une capacité si l'occasion se présente, sinon une attaque
+// Ils visent l'ennemi le plus affaibli
+func faireAgirAllies(joueur *character.Character, allies []*allie, ennemis []*Ennemi, effetsJoueur *Effets) {
+	for _, a := range allies {
+		vivants := ennemisVivants(ennemis)
+		if *a.pv <= 0 || len(vivants) == 0 || joueur.Pdv <= 0 {
+			continue
+		}
+		for nom, tours := range a.recharges {
+			if tours > 0 {
+				a.recharges[nom] = tours - 1
+			}
+		}
 		
-		// Mettre à jour le progrès des quêtes après victoire
-		joueur.MettreAJourProgresQuete(ennemi.Nom)
+		cible := vivants[0]
+		for _, ennemi := range vivants {
+			if ennemi.Pv < cible.Pv {
+				cible = ennemi
+			}
+		}
 		
-		// Gagner XP après victoire (calculer sur les PV originaux si l'ennemi est mort)
-		pvOriginaux := ennemi.Pv
-		if ennemi.Pv <= 0 {
-			// Estimer les PV originaux si l'ennemi est mort
-			pvOriginaux = ennemi.Attaque * 3 // Estimation basique
+		// Capacité : une seule tentée par tour, dans l'ordre de la liste
+		var capacite *Capacite
+		for i := range a.capacites {
+			c := &a.capacites[i]
+			if a.recharges[c.Nom] > 0 || (c.SeuilPv > 0 && joueur.Pdv*100 > joueur.Classe.Pvmax*c.SeuilPv) {
+				continue
+			}
+			if rand.Intn(100) < c.Chance {
+				capacite = c
+				break
+			}
 		}
-		xpGagne := 25 + (pvOriginaux / 2)
-		joueur.GagnerExperience(xpGagne)
-	} else if joueur.Pdv <= 0 {
-		fmt.Println("💀 Tu as été vaincu... Game Over.")
+		
+		if capacite != nil {
+			a.recharges[capacite.Nom] = capacite.Recharge
+			fmt.Printf("%s %s utilise %s : %s !\n", a.emoji, a.nom, capacite.Nom, capacite.Description)
+			if capacite.Soin > 0 {
+				soin := joueur.Classe.Pvmax * capacite.Soin / 100
+				if joueur.Pdv+soin > joueur.Classe.Pvmax {
+					soin = joueur.Classe.Pvmax - joueur.Pdv
+				}
+				joueur.Pdv += soin
+				fmt.Printf("💚 Vous récupérez %d PV.\n", soin)
+			}
+			if capacite.Multiplicateur > 0 {
+				a.frapper(cible, a.attaque*capacite.Multiplicateur/100)
+			}
+			if effet, existe := GetEffet(capacite.Effet); existe && effet.Benefique {
+				infligerEffet(capacite.Effet, effetsJoueur, &cible.Effets, joueur.Nom, cible.Nom)
+			} else if existe && cible.Pv > 0 {
+				infligerEffet(capacite.Effet, effetsJoueur, &cible.Effets, a.nom, cible.Nom)
+			}
+			continue
+		}
+		
+		a.frapper(cible, a.attaque)
+	}
+}
+
+// frapper inflige une attaque physique d'un allié à un ennemi, qui peut l'esquiver
+func (a *allie) frapper(ennemi *Ennemi, attaque int) {
+	if rand.Intn(100) >= chanceDeToucher(a.vitesse, ennemi.Vitesse) {
+		fmt.Printf("💨 %s attaque %s, qui l'esquive !\n", a.nom, ennemi.Nom)
+		return
+	}
+	degats, details := ennemi.calculerDegats(attaque, sorts.ElementPhysique)
+	ennemi.Pv -= degats
+	if len(details) > 0 {
+		fmt.Printf("%s %s attaque %s et inflige %d dégâts (%s) !\n", a.emoji, a.nom, ennemi.Nom, degats, strings.Join(details, ", "))
 	} else {
-		fmt.Println("🏃 Vous avez fui le combat avec succès !")
+		fmt.Printf("%s %s attaque %s et inflige %d dégâts !\n", a.emoji, a.nom, ennemi.Nom, degats)
+	}
+}
+
+// allieCible tire l'allié qu'un ennemi attaque à la place du joueur (nil si l'ennemi vise le joueur)
+func allieCible(allies []*allie) *allie {
+	debout := []*allie{}
+	for _, a := range allies {
+		if *a.pv > 0 {
+			debout = append(debout, a)
+		}
+	}
+	if len(debout) == 0 || rand.Intn(100) >= chanceCibleAllie {
+		return nil
+	}
+	return debout[rand.Intn(len(debout))]
+}
+
+// frapperAllie inflige l'attaque d'un ennemi à un allié, qui peut l'esquiver selon sa vitesse
+func frapperAllie(ennemi *Ennemi, a *allie, attaque int) {
+	if rand.Intn(100) >= chanceDeToucher(ennemi.Vitesse, a.vitesse) {
+		fmt.Printf("💨 %s attaque %s, qui l'esquive !\n", ennemi.Nom, a.nom)
+		return
+	}
+	*a.pv -= attaque
+	if *a.pv <= 0 {
+		*a.pv = 0
+		fmt.Printf("🔴 %s attaque %s et inflige %d dégâts. %s est K.O. !\n", ennemi.Nom, a.nom, attaque, a.nom)
+		return
+	}
+	fmt.Printf("🔴 %s attaque %s et inflige %d dégâts ! (PV %d/%d)\n", ennemi.Nom, a.nom, attaque, *a.pv, a.pvMax)
+}
+
+// tourEnnemi fait agir l'ennemi : une capacité choisie selon son comportement, ou son attaque de base
+// L'attaque de base peut viser un allié du joueur plutôt que le joueur lui-même
+func tourEnnemi(joueur *character.Character, ennemi *Ennemi, allies []*allie, effetsJoueur *Effets) {
+	for nom, tours := range ennemi.recharges {
+		if tours > 0 {
+			ennemi.recharges[nom] = tours - 1
+		}
+	}
+	attaque := ennemi.attaqueCombat + ennemi.Effets.ModAttaque()
+	
+	if capacite := ennemi.choisirCapacite(joueur, *effetsJoueur); capacite != nil {
+		ennemi.utiliserCapacite(capacite, joueur, attaque, effetsJoueur)
+		return
+	}
+	if a := allieCible(allies); a != nil {
+		frapperAllie(ennemi, a, attaque)
+		return
+	}
+	
+	touche := frapperJoueur(joueur, ennemi, attaque, effetsJoueur)
+	if touche && ennemi.EffetAttaque != "" && joueur.Pdv > 0 && rand.Intn(100) < ennemi.ChanceEffet {
+		infligerEffet(ennemi.EffetAttaque, &ennemi.Effets, effetsJoueur, ennemi.Nom, joueur.Nom)
+	}
+}
+
+// frapperJoueur inflige une attaque au joueur, réduite par sa défense d'équipement et ses effets
+// Le joueur peut l'esquiver selon son agilité ; retourne vrai si l'attaque a touché
+func frapperJoueur(joueur *character.Character, ennemi *Ennemi, attaque int, effetsJoueur *Effets) bool {
+	nomAttaquant := ennemi.Nom
+	
+	// Jet d'esquive du joueur : vitesse de l'ennemi contre agilité du joueur
+	agilite, _ := joueur.Classe.Statistiques()
+	precision := chanceDeToucher(ennemi.Vitesse, agilite)
+	if nomEsquive, esquive := effetsJoueur.esquiver(); esquive {
+		fmt.Printf("💨 %s t'attaque, mais tu l'esquives (%s) !\n", nomAttaquant, nomEsquive)
+		return false
+	}
+	if rand.Intn(100) >= precision {
+		fmt.Printf("💨 %s t'attaque, mais tu l'esquives ! (%d%% de chances d'être touché)\n", nomAttaquant, precision)
+		return false
+	}
+	if rand.Intn(100) < critiqueEnnemi {
+		attaque = attaque * multiplicateurCrit / 100
+		fmt.Printf("💥 Coup critique de %s ! (attaque x%d%%)\n", nomAttaquant, multiplicateurCrit)
+	}
+	
+	// Appliquer bonus de défense
+	bonusDefense := joueur.CalculerDefenseBonus()
+	bonusEffets := effetsJoueur.ModDefense()
+	defenseTotale := bonusDefense + bonusEffets
+	degatsSubis := attaque - defenseTotale
+	reduction := effetsJoueur.Reduction()
+	if reduction > 0 {
+		degatsSubis = degatsSubis * (100 - reduction) / 100
+	}
+	if degatsSubis < 1 {
+		degatsSubis = 1 // Minimum 1 dégât
+	}
+	
+	joueur.Pdv -= degatsSubis
+	
+	if reduction > 0 {
+		fmt.Printf("🔴 %s t'attaque ! Tu te protèges et subis %d dégâts (%d - %d défense, puis -%d%% de garde) !\n", nomAttaquant, degatsSubis, attaque, defenseTotale, reduction)
+	} else if bonusEffets != 0 {
+		fmt.Printf("🔴 %s t'attaque ! Tu subis %d dégâts (%d - %d défense, dont %+d d'effets) !\n", nomAttaquant, degatsSubis, attaque, defenseTotale, bonusEffets)
+	} else if bonusDefense > 0 {
+		fmt.Printf("🔴 %s t'attaque ! Tu subis %d dégâts (%d - %d défense) !\n", nomAttaquant, degatsSubis, attaque, bonusDefense)
+	} else {
+		fmt.Printf("🔴 %s t'attaque et inflige %d dégâts !\n", nomAttaquant, degatsSubis)
+	}
+	return true
+}
+
+// choisirCapacite sélectionne la capacité à utiliser selon l'état du combat (nil = attaque de base)
+// Les capacités sont examinées dans l'ordre de priorité du comportement, et chacune n'est tentée qu'avec sa probabilité
+func (e *Ennemi) choisirCapacite(joueur *character.Character, effetsJoueur Effets) *Capacite {
+	candidates := []*Capacite{}
+	for i := range e.Capacites {
+		c := &e.Capacites[i]
+		if e.recharges[c.Nom] > 0 {
+			continue
+		}
+		if c.SeuilPv > 0 && e.Pv*100 > e.PvMax*c.SeuilPv {
+			continue
+		}
+		if c.DrainMana > 0 && c.Multiplicateur == 0 && joueur.Mana == 0 {
+			continue
+		}
+		if c.Soin > 0 && e.Pv >= e.PvMax {
+			continue
+		}
+		// Inutile de relancer un effet déjà actif
+		if effet, existe := GetEffet(c.Effet); existe && c.Multiplicateur == 0 {
+			if (effet.Benefique && e.Effets.Actif(effet.Nom)) || (!effet.Benefique && effetsJoueur.Actif(effet.Nom)) {
+				continue
+			}
+		}
+		candidates = append(candidates, c)
+	}
+	
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return e.priorite(candidates[i], joueur) > e.priorite(candidates[j], joueur)
+	})
+	for _, c := range candidates {
+		if rand.Intn(100) < c.Chance {
+			return c
+		}
+	}
+	return nil
+}
+
+// priorite note une capacité selon le comportement de l'ennemi
+func (e *Ennemi) priorite(c *Capacite, joueur *character.Character) int {
+	effet, _ := GetEffet(c.Effet)
+	defensive := c.Soin > 0 || effet.Benefique
+	controle := c.DrainMana > 0 || (c.Effet != "" && !effet.Benefique)
+	
+	switch e.Comportement {
+	case ComportementPrudent:
+		if defensive {
+			return 3
+		}
+		if controle {
+			return 2
+		}
+	case ComportementRuse:
+		if controle {
+			// Vider le mana d'un joueur qui en a beaucoup est encore plus tentant
+			if c.DrainMana > 0 && joueur.Mana >= c.DrainMana {
+				return 4
+			}
+			return 3
+		}
+		if defensive {
+			return 2
+		}
+	default:
+		if c.Multiplicateur > 0 {
+			return 3
+		}
+		if defensive {
+			return 2
+		}
+	}
+	return 1
+}
+
+// utiliserCapacite applique une capacité de l'ennemi et la met en recharge
+func (e *Ennemi) utiliserCapacite(c *Capacite, joueur *character.Character, attaque int, effetsJoueur *Effets) {
+	if e.recharges == nil {
+		e.recharges = map[string]int{}
+	}
+	e.recharges[c.Nom] = c.Recharge
+	fmt.Printf("🌀 %s utilise %s : il %s !\n", e.Nom, c.Nom, c.Description)
+	
+	if c.Multiplicateur > 0 {
+		if !frapperJoueur(joueur, e, attaque*c.Multiplicateur/100, effetsJoueur) {
+			return
+		}
+	}
+	if c.DrainMana > 0 {
+		vole := c.DrainMana
+		if vole > joueur.Mana {
+			vole = joueur.Mana
+		}
+		joueur.Mana -= vole
+		fmt.Printf("🔮 %s vous vole %d mana ! (Mana : %d)\n", e.Nom, vole, joueur.Mana)
+	}
+	if c.Soin > 0 {
+		anciensPV := e.Pv
+		e.Pv += e.PvMax * c.Soin / 100
+		if e.Pv > e.PvMax {
+			e.Pv = e.PvMax
+		}
+		fmt.Printf("💚 %s récupère %d PV !\n", e.Nom, e.Pv-anciensPV)
+	}
+	if c.Effet != "" && joueur.Pdv > 0 {
+		infligerEffet(c.Effet, &e.Effets, effetsJoueur, e.Nom, joueur.Nom)
 	}
 }
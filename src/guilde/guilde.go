@@ -0,0 +1,137 @@
+// Package guilde gère les guildes de mercenaires des villes
+// Permet d'engager des mercenaires qui suivent le joueur contre un salaire journalier et une part du butin
+package guilde
+
+import (
+	"fmt"
+	"world_of_milousques/character"
+	"world_of_milousques/ui"
+	"world_of_milousques/utils"
+)
+
+// tailleEquipeMax est le nombre maximum de mercenaires dans l'équipe du joueur
+const tailleEquipeMax = 2
+
+// Recrue représente un mercenaire disponible à l'embauche
+type Recrue struct {
+	Mercenaire character.Mercenaire
+	Prix       int // Prime d'embauche
+}
+
+// Guilde représente une guilde de mercenaires et ses recrues
+type Guilde struct {
+	Nom     string
+	Maitre  string
+	Accueil string
+	Recrues []Recrue
+}
+
+// GetGuilde retourne une guilde à partir de son nom
+func GetGuilde(nom string) (Guilde, bool) {
+	switch nom {
+	case "Guilde des Lames d'Astrab":
+		return Guilde{
+			Nom:     "Guilde des Lames d'Astrab",
+			Maitre:  "Capitaine Ombeline",
+			Accueil: "Mes lames sont les meilleures du royaume. Elles vous suivront partout... tant que vous payez.",
+			Recrues: []Recrue{
+				{Mercenaire: character.Mercenaire{Nom: "Brann l'Épéiste", Role: "Épéiste", Pv: 140, PvMax: 140, Attaque: 28, Vitesse: 9, Salaire: 30, PartButin: 10}, Prix: 150},
+				{Mercenaire: character.Mercenaire{Nom: "Lysa l'Archère", Role: "Archère", Pv: 90, PvMax: 90, Attaque: 24, Vitesse: 16, Salaire: 35, PartButin: 15}, Prix: 180},
+				{Mercenaire: character.Mercenaire{Nom: "Frère Anselme", Role: "Guérisseur", Pv: 80, PvMax: 80, Attaque: 12, Vitesse: 11, Salaire: 40, PartButin: 10}, Prix: 200},
+			},
+		}, true
+	default:
+		return Guilde{}, false
+	}
+}
+
+// AfficherGuilde affiche le menu de la guilde
+func AfficherGuilde(joueur *character.Character, nomGuilde string) {
+	guilde, existe := GetGuilde(nomGuilde)
+	if !existe {
+		fmt.Printf("❌ %s est fermée.\n", nomGuilde)
+		return
+	}
+
+	fmt.Printf("\n🗡️  === %s === 🗡️\n", guilde.Nom)
+	fmt.Printf("%s : %s\n", guilde.Maitre, guilde.Accueil)
+
+	for {
+		fmt.Printf("💳 %d pièces d'or | Équipe : %d/%d mercenaires\n", joueur.Argent, len(joueur.Mercenaires), tailleEquipeMax)
+
+		options := []string{}
+		for _, recrue := range guilde.Recrues {
+			m := recrue.Mercenaire
+			fmt.Printf("- %s (%s) : PV %d, Attaque %d | %d or/jour et %d%% du butin\n", m.Nom, m.Role, m.PvMax, m.Attaque, m.Salaire, m.PartButin)
+			options = append(options, fmt.Sprintf("Engager %s - %d or", m.Nom, recrue.Prix))
+		}
+		options = append(options, "Congédier un mercenaire", "Quitter la guilde")
+
+		ui.AfficherMenu("Guilde", options)
+		choix := utils.ScanChoice("Que voulez-vous faire ? ", options)
+
+		switch {
+		case choix >= 1 && choix <= len(guilde.Recrues):
+			engager(joueur, guilde, guilde.Recrues[choix-1])
+		case choix == len(guilde.Recrues)+1:
+			congedier(joueur, guilde)
+		default:
+			fmt.Printf("%s : Revenez quand vous aurez besoin de bras.\n", guilde.Maitre)
+			return
+		}
+	}
+}
+
+// engager fait payer la prime d'embauche et ajoute le mercenaire à l'équipe
+func engager(joueur *character.Character, guilde Guilde, recrue Recrue) {
+	for _, m := range joueur.Mercenaires {
+		if m.Nom == recrue.Mercenaire.Nom {
+			fmt.Printf("⚠️  %s fait déjà partie de votre équipe.\n", m.Nom)
+			return
+		}
+	}
+	if len(joueur.Mercenaires) >= tailleEquipeMax {
+		fmt.Printf("%s : Votre équipe est complète, congédiez quelqu'un d'abord.\n", guilde.Maitre)
+		return
+	}
+	if joueur.Argent < recrue.Prix {
+		fmt.Printf("💸 %s : Il vous faut %d pièces d'or pour engager %s.\n", guilde.Maitre, recrue.Prix, recrue.Mercenaire.Nom)
+		return
+	}
+
+	joueur.Argent -= recrue.Prix
+	joueur.EmbaucherMercenaire(recrue.Mercenaire)
+	fmt.Printf("🤝 %s rejoint votre équipe ! Premier salaire de %d or dans 24 heures.\n", recrue.Mercenaire.Nom, recrue.Mercenaire.Salaire)
+
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+}
+
+// congedier fait choisir un mercenaire à renvoyer de l'équipe
+func congedier(joueur *character.Character, guilde Guilde) {
+	if len(joueur.Mercenaires) == 0 {
+		fmt.Println("Vous n'avez aucun mercenaire à congédier.")
+		return
+	}
+
+	options := []string{}
+	for _, m := range joueur.Mercenaires {
+		options = append(options, fmt.Sprintf("%s (%s, PV %d/%d)", m.Nom, m.Role, m.Pv, m.PvMax))
+	}
+	options = append(options, "Annuler")
+
+	ui.AfficherMenu("Congédier", options)
+	choix := utils.ScanChoice("Quel mercenaire congédier ? ", options)
+	if choix < 1 || choix > len(joueur.Mercenaires) {
+		return
+	}
+
+	nom := joueur.Mercenaires[choix-1].Nom
+	joueur.CongedierMercenaire(choix - 1)
+	fmt.Printf("👋 %s quitte votre équipe. %s : Bonne route à vous deux.\n", nom, guilde.Maitre)
+
+	if err := joueur.Sauvegarder(); err != nil {
+		fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+	}
+}
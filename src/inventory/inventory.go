@@ -28,6 +28,34 @@ func (inv *Inventaire) AddItem(it item.Item, quantity int) bool {
 	return true
 }
 
+func (inv *Inventaire) CompterItem(nom string) int {
+	count := 0
+	for _, it := range inv.Items {
+		if it.Nom == nom {
+			count++
+		}
+	}
+	return count
+}
+
+func (inv *Inventaire) RetirerItem(nom string, quantity int) bool {
+	if inv.CompterItem(nom) < quantity {
+		return false
+	}
+	
+	retires := 0
+	restants := make([]item.Item, 0, len(inv.Items))
+	for _, it := range inv.Items {
+		if it.Nom == nom && retires < quantity {
+			retires++
+			continue
+		}
+		restants = append(restants, it)
+	}
+	inv.Items = restants
+	return true
+}
+
 func (inv *Inventaire) Recolter(ressources []item.Item) {
 	if len(ressources) == 0 {
 		fmt.Println("Aucune ressource à récolter ici.")
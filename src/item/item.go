@@ -12,7 +12,9 @@ const (
 	TypeTorse     ItemType = "torse"
 	TypeJambiere  ItemType = "jambiere"
 	TypePotion    ItemType = "potion"
+	TypeOutil     ItemType = "outil"
 	TypeSpecial   ItemType = "special"
+	TypeConsommable ItemType = "consommable"
 )
 
 type Item struct {
@@ -24,6 +26,7 @@ type Item struct {
 	Attaque     int    // Bonus d'attaque pour les armes
 	Defense     int    // Bonus de défense pour les armures
 	ClasseRequise string // Classe requise pour équiper ("" = toutes)
+	EffetCombat   string // Effet de statut appliqué quand on l'utilise en combat ("" = aucun)
 }
 
 func NewItem(nom string) Item {
@@ -80,6 +83,42 @@ func NewItem(nom string) Item {
 	case "Potion de Mana":
 		return Item{Nom: "Potion de Mana", Type: TypePotion, Poids: 2, Effet: "Restaure 50 Mana", Valeur: 50}
 	
+	// === CONSOMMABLES DE COMBAT ===
+	case "Bombe incendiaire":
+		return Item{Nom: "Bombe incendiaire", Type: TypeConsommable, Poids: 2, Effet: "Enflamme l'ennemi pendant 2 tours", Valeur: 40, EffetCombat: "Brûlure"}
+	case "Fiole de venin":
+		return Item{Nom: "Fiole de venin", Type: TypeConsommable, Poids: 1, Effet: "Empoisonne l'ennemi pendant 3 tours", Valeur: 35, EffetCombat: "Poison"}
+	case "Élixir de pierre":
+		return Item{Nom: "Élixir de pierre", Type: TypeConsommable, Poids: 2, Effet: "Durcit la peau : +15 défense pendant 3 tours", Valeur: 60, EffetCombat: "Bouclier"}
+	case "Baume de régénération":
+		return Item{Nom: "Baume de régénération", Type: TypeConsommable, Poids: 1, Effet: "Rend 12 PV par tour pendant 3 tours", Valeur: 45, EffetCombat: "Régénération"}
+	case "Collier d'apprivoisement":
+		return Item{Nom: "Collier d'apprivoisement", Type: TypeConsommable, Poids: 1, Effet: "Apprivoise un Milousque affaibli pour en faire un compagnon", Valeur: 300, EffetCombat: "Apprivoisement"}
+	
+	// === OUTILS D'EXPLORATION ===
+	case "Barque":
+		return Item{Nom: "Barque", Type: TypeOutil, Poids: 30, Effet: "Permet de naviguer sur les eaux profondes", Valeur: 200}
+	case "Lanterne":
+		return Item{Nom: "Lanterne", Type: TypeOutil, Poids: 4, Effet: "Éclaire les galeries les plus sombres", Valeur: 120}
+	
+	// === BUTINS UNIQUES ===
+	case "Cœur de Kairis":
+		return Item{Nom: "Cœur de Kairis", Type: TypeSpecial, Poids: 3, Effet: "Le cœur encore chaud de la Reine des Kairis", Valeur: 600}
+	case "Pioche du Contremaître":
+		return Item{Nom: "Pioche du Contremaître", Type: TypeSpecial, Poids: 12, Effet: "Souvenir d'un contremaître passé du côté des Kairis", Valeur: 350}
+	case "Plume de Milousque":
+		return Item{Nom: "Plume de Milousque", Type: TypeSpecial, Poids: 1, Effet: "Une plume irisée qui ne touche jamais le sol", Valeur: 800}
+	case "Couronne du Grand Milousque":
+		return Item{Nom: "Couronne du Grand Milousque", Type: TypeSpecial, Poids: 5, Effet: "La preuve que vous avez dompté le plus puissant des Milousques", Valeur: 2000}
+	case "Casque du Contremaître":
+		return Item{Nom: "Casque du Contremaître", Type: TypeCasque, Poids: 9, Effet: "Un casque de mineur cabossé par mille éboulements", Valeur: 700, Defense: 14}
+	case "Carapace de la Reine":
+		return Item{Nom: "Carapace de la Reine", Type: TypeTorse, Poids: 16, Effet: "La chitine de la Reine Kairis, plus dure que l'acier", Valeur: 1200, Defense: 18}
+	case "Jambières des Brumes":
+		return Item{Nom: "Jambières des Brumes", Type: TypeJambiere, Poids: 6, Effet: "Tissées de brume, elles ne pèsent presque rien", Valeur: 1500, Defense: 16}
+	case "Croc du Grand Milousque":
+		return Item{Nom: "Croc du Grand Milousque", Type: TypeArme, Poids: 10, Effet: "Un croc ancestral qui s'adapte à la main de son porteur", Valeur: 3000, Attaque: 35}
+	
 	default:
 		return Item{Nom: nom, Type: TypeSpecial, Poids: 10, Effet: "Objet mystérieux aux propriétés inconnues", Valeur: 10}
 	}
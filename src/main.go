@@ -16,11 +16,14 @@ import (
 	"world_of_milousques/places"
 	"world_of_milousques/ui"
 	"world_of_milousques/utils"
+	"world_of_milousques/world"
 )
 
 func main() {
 	rand.Seed(time.Now().UnixNano())
 	
+	configurerAffichage(os.Args[1:])
+	
 	c := gererMenuPrincipal()
 	if c == nil {
 		return
@@ -40,6 +43,26 @@ func main() {
 	exploration.ExplorerMap(c)
 }
 
+// configurerAffichage lit les options de la ligne de commande pour le rendu de la carte
+// --no-color désactive les couleurs ANSI, --ascii remplace les emojis par des caractères simples
+func configurerAffichage(args []string) {
+	// Convention NO_COLOR : toute valeur non vide désactive les couleurs
+	if os.Getenv("NO_COLOR") != "" {
+		world.Affichage.Couleurs = false
+	}
+	
+	for _, arg := range args {
+		switch arg {
+		case "--no-color":
+			world.Affichage.Couleurs = false
+		case "--ascii":
+			world.Affichage.ASCII = true
+		default:
+			fmt.Printf("⚠️  Option inconnue ignorée : %s\n", arg)
+		}
+	}
+}
+
 // executerIntroductionOuReprise gère l'introduction pour un nouveau joueur ou la reprise d'aventure
 // Retourne true si le jeu peut continuer, false si le joueur a été vaincu
 func executerIntroductionOuReprise(c *character.Character) bool {
@@ -253,6 +276,10 @@ func reprendrePersonnage() *character.Character {
 func afficherPersonnageComplet(c *character.Character) {
 	afficherPersonnageResume(c)
 	
+	// Statistiques de combat
+	agilite, critique := c.Classe.Statistiques()
+	fmt.Printf("   🎯 Agilité : %d | Chance de critique : %d%%\n", agilite, critique)
+	
 	// Équipement détaillé
 	afficherEquipementDetaille(c)
 	
@@ -260,7 +287,23 @@ func afficherPersonnageComplet(c *character.Character) {
 	if len(c.Classe.Sorts) > 0 {
 		fmt.Println("\nSorts disponibles :")
 		for _, s := range c.Classe.Sorts {
-			fmt.Printf("- %s (Dégâts : %d, Coût en mana : %d)\n", s.Nom, s.Degats, s.Cout)
+			fmt.Printf("- %s %s (%s, Dégâts : %d, Coût en mana : %d)\n", s.TypeDegats().Emoji(), s.Nom, s.TypeDegats(), s.Degats, s.Cout)
+		}
+	}
+	
+	// Compagnons apprivoisés
+	if len(c.Compagnons) > 0 {
+		fmt.Println("\nCompagnons :")
+		for _, compagnon := range c.Compagnons {
+			fmt.Printf("- 🐾 %s (%s niveau %d, PV : %d/%d, Attaque : %d)\n", compagnon.Nom, compagnon.Espece, compagnon.Niveau, compagnon.Pv, compagnon.PvMax, compagnon.Attaque)
+		}
+	}
+	
+	// Mercenaires engagés
+	if len(c.Mercenaires) > 0 {
+		fmt.Println("\nMercenaires :")
+		for _, mercenaire := range c.Mercenaires {
+			fmt.Printf("- 🗡️  %s (%s, PV : %d/%d, Attaque : %d, Salaire : %d or/jour)\n", mercenaire.Nom, mercenaire.Role, mercenaire.Pv, mercenaire.PvMax, mercenaire.Attaque, mercenaire.Salaire)
 		}
 	}
 }
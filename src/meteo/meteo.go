@@ -0,0 +1,111 @@
+// Package meteo gère la météo de chaque région de la carte
+// La météo évolue avec l'horloge du jeu et modifie les récoltes, les monstres et les déplacements
+package meteo
+
+import "fmt"
+
+// DureePeriode est le nombre d'heures de jeu pendant lesquelles une météo reste stable
+const DureePeriode = 6
+
+// Meteo représente les conditions climatiques d'une région
+type Meteo struct {
+	Nom                         string
+	Emoji                       string
+	Description                 string
+	ModificateurRecolte         int // En pourcentage (+25 = 25% de ressources en plus)
+	ModificateurAttaqueMonstres int // En pourcentage (+20 = monstres 20% plus forts)
+	CoutDeplacement             int // Heures supplémentaires pour traverser la zone
+}
+
+// GetMeteo retourne une météo à partir de son nom
+func GetMeteo(nom string) Meteo {
+	switch nom {
+	case "Ensoleillé":
+		return Meteo{Nom: "Ensoleillé", Emoji: "☀️", Description: "Un grand soleil éclaire la région."}
+	case "Nuageux":
+		return Meteo{Nom: "Nuageux", Emoji: "☁️", Description: "Quelques nuages gris passent au-dessus de vous."}
+	case "Pluie":
+		return Meteo{
+			Nom:                 "Pluie",
+			Emoji:               "🌧️",
+			Description:         "Une pluie battante fait monter les eaux, les pichons mordent à tout va !",
+			ModificateurRecolte: 25,
+			CoutDeplacement:     1,
+		}
+	case "Orage":
+		return Meteo{
+			Nom:                         "Orage",
+			Emoji:                       "⛈️",
+			Description:                 "Le tonnerre gronde dans les galeries, les monstres sont enragés.",
+			ModificateurRecolte:         -25,
+			ModificateurAttaqueMonstres: 20,
+			CoutDeplacement:             1,
+		}
+	case "Brouillard":
+		return Meteo{
+			Nom:                         "Brouillard",
+			Emoji:                       "🌫️",
+			Description:                 "Un brouillard épais cache les ressources et les monstres en embuscade.",
+			ModificateurRecolte:         -25,
+			ModificateurAttaqueMonstres: 10,
+			CoutDeplacement:             1,
+		}
+	case "Vent":
+		return Meteo{
+			Nom:                 "Vent",
+			Emoji:               "🌬️",
+			Description:         "Un vent violent couche les cultures.",
+			ModificateurRecolte: -10,
+		}
+	default:
+		return Meteo{Nom: nom, Emoji: "❔", Description: "Le temps est incertain."}
+	}
+}
+
+// getCycleRegion retourne le cycle de météos possibles pour une région
+// Une météo répétée dans le cycle est plus fréquente
+func getCycleRegion(region string) []string {
+	switch region {
+	case "Rivière":
+		return []string{"Ensoleillé", "Pluie", "Nuageux", "Pluie", "Pluie", "Ensoleillé"}
+	case "Mine":
+		return []string{"Nuageux", "Orage", "Orage", "Nuageux", "Orage", "Ensoleillé"}
+	case "Forêt":
+		return []string{"Brouillard", "Ensoleillé", "Brouillard", "Nuageux", "Brouillard", "Pluie"}
+	case "Champs":
+		return []string{"Ensoleillé", "Vent", "Ensoleillé", "Nuageux", "Vent", "Pluie"}
+	default: // Routes et villes
+		return []string{"Ensoleillé", "Nuageux", "Ensoleillé", "Pluie"}
+	}
+}
+
+// MeteoRegion retourne la météo d'une région à un instant donné de l'horloge du jeu
+// Le calcul est déterministe : une sauvegarde rechargée retrouve la même météo
+func MeteoRegion(region string, temps int) Meteo {
+	cycle := getCycleRegion(region)
+
+	// Décaler le cycle selon la région pour éviter que toutes les régions changent ensemble
+	decalage := 0
+	for _, r := range region {
+		decalage += int(r)
+	}
+
+	periode := temps / DureePeriode
+	index := (periode*7 + decalage) % len(cycle)
+	return GetMeteo(cycle[index])
+}
+
+// Libelle retourne le nom de la météo précédé de son emoji
+func (m Meteo) Libelle() string {
+	return fmt.Sprintf("%s %s", m.Emoji, m.Nom)
+}
+
+// AppliquerRecolte calcule le nombre de ressources obtenues pour une quantité disponible
+func (m Meteo) AppliquerRecolte(quantite int) int {
+	return quantite + quantite*m.ModificateurRecolte/100
+}
+
+// AppliquerAttaque calcule l'attaque d'un monstre modifiée par la météo
+func (m Meteo) AppliquerAttaque(attaque int) int {
+	return attaque + attaque*m.ModificateurAttaqueMonstres/100
+}
@@ -0,0 +1,264 @@
+// Package region gère les régions secrètes situées au-delà des bords de la carte
+// Chaque région a sa propre petite carte et abrite le repaire d'un Milousque
+package region
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+	"world_of_milousques/character"
+	"world_of_milousques/fight"
+	"world_of_milousques/item"
+	"world_of_milousques/ui"
+	"world_of_milousques/utils"
+)
+
+// Position dans la carte d'une région
+type Position struct {
+	X, Y int
+}
+
+// Case représente un lieu de la carte d'une région
+type Case struct {
+	Nom         string
+	Description string
+	Repaire     bool // Le Milousque de la région attend ici
+}
+
+// Region représente une région secrète et ses conditions d'accès
+type Region struct {
+	Nom         string
+	Description string
+	Cases       [][]Case // Indexées par [y][x]
+	Entree      Position
+	// Habitants
+	Monstres        []fight.Ennemi
+	ChanceRencontre int // Probabilité (en %) d'être attaqué en entrant dans une case
+	Milousque       fight.Ennemi
+	RecompenseOr    int
+	RecompenseObjet string
+	MessageVictoire string
+	// Déverrouillage par une chaîne de quêtes
+	QuetesRequises []string
+	RegionRequise  string // Région dont le Milousque doit déjà être vaincu ("" = aucune)
+}
+
+// GetRegion retourne une région secrète à partir de son nom
+func GetRegion(nom string) *Region {
+	switch nom {
+	case "Sentier des Brumes":
+		return &Region{
+			Nom:         "Sentier des Brumes",
+			Description: "Au-delà des derniers arbres, un sentier disparaît dans une brume qui ne se lève jamais.",
+			Cases: [][]Case{
+				{
+					{Nom: "Clairière pâle", Description: "Les arbres sont blancs comme de l'os. Rien ne pousse au sol."},
+					{Nom: "Nid abandonné", Description: "Un nid immense, tapissé de plumes irisées.", Repaire: true},
+					{Nom: "Falaise", Description: "Le sentier longe un vide dont on ne voit pas le fond."},
+				},
+				{
+					{Nom: "Bosquet murmurant", Description: "Les feuilles chuchotent votre nom."},
+					{Nom: "Carrefour des brumes", Description: "Quatre sentiers identiques partent dans la brume."},
+					{Nom: "Étang gelé", Description: "La glace reflète un ciel qui n'est pas le vôtre."},
+				},
+				{
+					{Nom: "Orée", Description: "La forêt familière n'est plus qu'une ombre derrière vous."},
+					{Nom: "Sentier", Description: "Des empreintes griffues s'enfoncent vers le nord."},
+					{Nom: "Ruines", Description: "Des pierres couvertes des mêmes runes que celles de la forêt."},
+				},
+			},
+			Entree: Position{X: 0, Y: 2},
+			Monstres: []fight.Ennemi{
+				{Nom: "Feu follet", Pv: 120, Attaque: 40},
+				{Nom: "Loup des brumes", Pv: 160, Attaque: 45},
+			},
+			ChanceRencontre: 40,
+			Milousque:       fight.Ennemi{Nom: "Milousque des Brumes", Pv: 600, Attaque: 55},
+			RecompenseOr:    500,
+			RecompenseObjet: "Plume de Milousque",
+			MessageVictoire: "Le Milousque des Brumes s'incline devant vous. La brume se lève un instant et révèle, loin à l'est, une île au milieu de la rivière...",
+			QuetesRequises:  []string{"Nettoyage de Forêt", "Raid des Champs"},
+		}
+	case "Île Milousque":
+		return &Region{
+			Nom:         "Île Milousque",
+			Description: "Au bout de la rivière profonde, une île que personne n'a jamais portée sur une carte.",
+			Cases: [][]Case{
+				{
+					{Nom: "Plage de nacre", Description: "Le sable scintille comme des milliers de perles."},
+					{Nom: "Jungle dense", Description: "Des lianes épaisses comme des bras barrent le passage."},
+					{Nom: "Sommet", Description: "Le trône du Grand Milousque domine toute l'île.", Repaire: true},
+				},
+				{
+					{Nom: "Ponton", Description: "Votre barque est amarrée à un ponton de bois flotté."},
+					{Nom: "Source chaude", Description: "Une vapeur sucrée s'échappe d'un bassin turquoise."},
+					{Nom: "Grotte aux échos", Description: "Chaque pas résonne comme un rugissement."},
+				},
+			},
+			Entree: Position{X: 0, Y: 1},
+			Monstres: []fight.Ennemi{
+				{Nom: "Crabe titanesque", Pv: 200, Attaque: 55},
+				{Nom: "Gardien Milousque", Pv: 240, Attaque: 60},
+			},
+			ChanceRencontre: 45,
+			Milousque:       fight.Ennemi{Nom: "Grand Milousque", Pv: 900, Attaque: 70},
+			RecompenseOr:    1000,
+			RecompenseObjet: "Couronne du Grand Milousque",
+			MessageVictoire: "Le Grand Milousque est dompté ! Son pouvoir incommensurable vous appartient. Mathiouw avait raison : vous êtes devenu un véritable chasseur de Milousques.",
+			QuetesRequises:  []string{"Nettoyage des Rivières", "Répression des Kairis"},
+			RegionRequise:   "Sentier des Brumes",
+		}
+	default:
+		return nil
+	}
+}
+
+// RaisonVerrou retourne pourquoi le joueur ne peut pas encore entrer dans la région ("" si elle est ouverte)
+func (r *Region) RaisonVerrou(joueur *character.Character) string {
+	manquantes := []string{}
+	for _, quete := range r.QuetesRequises {
+		if !joueur.QueteAccomplie(quete) {
+			manquantes = append(manquantes, quete)
+		}
+	}
+	if r.RegionRequise != "" && !joueur.AVaincuMilousque(r.RegionRequise) {
+		manquantes = append(manquantes, "vaincre le Milousque de "+r.RegionRequise)
+	}
+
+	if len(manquantes) == 0 {
+		return ""
+	}
+	return "Il vous reste à accomplir : " + strings.Join(manquantes, ", ")
+}
+
+// Explorer lance l'exploration de la région jusqu'au retour dans le monde ou la mort du joueur
+func Explorer(joueur *character.Character, r *Region) {
+	fmt.Printf("\n🌀 === %s === 🌀\n", strings.ToUpper(r.Nom))
+	fmt.Println(r.Description)
+
+	position := r.Entree
+	visitees := map[Position]bool{position: true}
+
+	for joueur.Pdv > 0 {
+		afficherCarte(r, position, visitees, joueur)
+
+		caseActuelle := r.Cases[position.Y][position.X]
+		fmt.Printf("\n📍 %s : %s\n", caseActuelle.Nom, caseActuelle.Description)
+		fmt.Printf("PV : %d/%d | Mana : %d/%d | %s\n", joueur.Pdv, joueur.PdvMax, joueur.Mana, joueur.ManaMax, joueur.DescriptionTemps())
+
+		fmt.Println("Z = Nord | S = Sud | Q = Ouest | D = Est | P = Boire une potion")
+		if position == r.Entree {
+			fmt.Println("R = Retourner dans le monde connu")
+		}
+		choix := strings.ToUpper(strings.TrimSpace(utils.ScanString("Votre choix : ", 1)))
+
+		destination := position
+		switch choix {
+		case "Z":
+			destination.Y--
+		case "S":
+			destination.Y++
+		case "Q":
+			destination.X--
+		case "D":
+			destination.X++
+		case "P":
+			joueur.UtiliserPotion()
+			continue
+		case "R":
+			if position == r.Entree {
+				fmt.Println("\n🌍 Vous retrouvez le monde connu.")
+				return
+			}
+			fmt.Println("Le chemin du retour part de l'entrée de la région.")
+			continue
+		default:
+			fmt.Println("Choix invalide.")
+			continue
+		}
+
+		if destination.Y < 0 || destination.Y >= len(r.Cases) || destination.X < 0 || destination.X >= len(r.Cases[destination.Y]) {
+			fmt.Println("⛔ Une brume infranchissable vous barre la route.")
+			continue
+		}
+
+		position = destination
+		visitees[position] = true
+		joueur.AvancerTemps(1)
+		entrerDansCase(joueur, r, r.Cases[position.Y][position.X])
+
+		if joueur.Pdv > 0 {
+			if err := joueur.Sauvegarder(); err != nil {
+				fmt.Println("⚠️  Erreur lors de la sauvegarde automatique:", err)
+			}
+		}
+	}
+}
+
+// afficherCarte affiche la carte de la région : les cases visitées, le repaire et la position du joueur
+func afficherCarte(r *Region, position Position, visitees map[Position]bool, joueur *character.Character) {
+	fmt.Printf("\n=== %s ===\n", r.Nom)
+	for y, ligne := range r.Cases {
+		rangee := ""
+		for x, c := range ligne {
+			p := Position{X: x, Y: y}
+			switch {
+			case p == position:
+				rangee += "[@]"
+			case c.Repaire && visitees[p] && !joueur.AVaincuMilousque(r.Nom):
+				rangee += "[M]"
+			case visitees[p]:
+				rangee += "[ ]"
+			default:
+				rangee += "[?]"
+			}
+		}
+		fmt.Println(rangee)
+	}
+	fmt.Println("@ = Vous | M = Repaire du Milousque | ? = Inexploré")
+}
+
+// entrerDansCase résout l'arrivée dans une case : repaire du Milousque ou rencontre aléatoire
+func entrerDansCase(joueur *character.Character, r *Region, c Case) {
+	if c.Repaire && !joueur.AVaincuMilousque(r.Nom) {
+		affronterMilousque(joueur, r)
+		return
+	}
+
+	if len(r.Monstres) == 0 || rand.Intn(100) >= r.ChanceRencontre {
+		return
+	}
+
+	monstre := r.Monstres[rand.Intn(len(r.Monstres))]
+	fmt.Printf("\n👹 Un %s surgit de la brume !\n", monstre.Nom)
+	fight.Fight(joueur, &monstre)
+}
+
+// affronterMilousque propose d'affronter le Milousque de la région
+func affronterMilousque(joueur *character.Character, r *Region) {
+	milousque := r.Milousque
+	fmt.Printf("\n✨ %s se dresse devant vous ! (PV: %d, Attaque: %d)\n", milousque.Nom, milousque.Pv, milousque.Attaque)
+
+	options := []string{"Affronter le Milousque", "Reculer prudemment"}
+	ui.AfficherMenu("Repaire", options)
+	if utils.ScanChoice("Que voulez-vous faire ? ", options) != 1 {
+		fmt.Println("Vous reculez hors du repaire. Le Milousque vous observe partir...")
+		return
+	}
+
+	fight.Fight(joueur, &milousque)
+	if milousque.Pv > 0 || milousque.EstApprivoise() || joueur.Pdv <= 0 {
+		return
+	}
+
+	joueur.EnregistrerMilousqueVaincu(r.Nom)
+	fmt.Printf("\n🏆 %s\n", r.MessageVictoire)
+	fmt.Printf("💰 Vous trouvez %d pièces d'or dans le repaire !\n", r.RecompenseOr)
+	joueur.Argent += joueur.RetenirPartMercenaires(r.RecompenseOr)
+	if r.RecompenseObjet != "" && joueur.Inventaire.AddItem(item.NewItem(r.RecompenseObjet), 1) {
+		fmt.Printf("🎁 Vous obtenez : %s !\n", r.RecompenseObjet)
+	}
+
+	fmt.Println("\nAppuyez sur Entrée pour continuer...")
+	fmt.Scanln()
+}
@@ -1,54 +1,128 @@
 package sorts
 
+// Element représente le type de dégâts infligés par un sort
+type Element string
+
+const (
+	ElementPhysique Element = "Physique"
+	ElementFeu      Element = "Feu"
+	ElementTerre    Element = "Terre"
+	ElementPoison   Element = "Poison"
+	ElementArcane   Element = "Arcane"
+)
+
+// Emoji retourne l'icône associée à un élément
+func (e Element) Emoji() string {
+	switch e {
+	case ElementFeu:
+		return "🔥"
+	case ElementTerre:
+		return "🌋"
+	case ElementPoison:
+		return "🐍"
+	case ElementArcane:
+		return "✨"
+	default:
+		return "👊"
+	}
+}
+
 type Sorts struct {
-	Nom    string
-	Degats int
-	Cout   int
+	Nom         string
+	Degats      int
+	Cout        int
+	Element     Element
+	Effet       string // Effet de statut que le sort peut appliquer ("" = aucun)
+	ChanceEffet int    // Probabilité (en %) d'appliquer l'effet
+	Zone        bool   // Touche tous les ennemis du combat
+}
+
+// TypeDegats retourne l'élément du sort
+// Les sorts des anciennes sauvegardes n'ont pas d'élément : on le retrouve à partir du nom
+func (s Sorts) TypeDegats() Element {
+	if s.Element != "" {
+		return s.Element
+	}
+	return GetSorts(s.Nom).Element
+}
+
+// EstDeZone indique si le sort touche tous les ennemis, en le retrouvant à partir du nom pour les anciennes sauvegardes
+func (s Sorts) EstDeZone() bool {
+	return s.Zone || GetSorts(s.Nom).Zone
+}
+
+// EffetSecondaire retourne l'effet de statut du sort et sa probabilité, en les retrouvant à partir du nom pour les anciennes sauvegardes
+func (s Sorts) EffetSecondaire() (string, int) {
+	if s.Effet != "" {
+		return s.Effet, s.ChanceEffet
+	}
+	reference := GetSorts(s.Nom)
+	return reference.Effet, reference.ChanceEffet
 }
 
 func GetSorts(nom string) Sorts {
 	switch nom {
 	case "Boule de feu":
 		return Sorts{
-			Nom:    "Boule de feu",
-			Degats: 30,
-			Cout:   20,
+			Nom:         "Boule de feu",
+			Degats:      30,
+			Cout:        20,
+			Element:     ElementFeu,
+			Effet:       "Brûlure",
+			ChanceEffet: 50,
 		}
 	case "Explosion":
 		return Sorts{
-			Nom:    "Explosion",
-			Degats: 50,
-			Cout:   40,
+			Nom:         "Explosion",
+			Degats:      50,
+			Cout:        40,
+			Element:     ElementArcane,
+			Effet:       "Étourdissement",
+			ChanceEffet: 30,
+			Zone:        true,
 		}
 	case "Coup bas":
 		return Sorts{
-			Nom:    "Coup bas",
-			Degats: 25,
-			Cout:   15,
+			Nom:         "Coup bas",
+			Degats:      25,
+			Cout:        15,
+			Element:     ElementPhysique,
+			Effet:       "Saignement",
+			ChanceEffet: 50,
 		}
 	case "Fourberie":
 		return Sorts{
-			Nom:    "Fourberie",
-			Degats: 10,
-			Cout:   0,
+			Nom:         "Fourberie",
+			Degats:      10,
+			Cout:        0,
+			Element:     ElementPoison,
+			Effet:       "Poison",
+			ChanceEffet: 100,
 		}
 	case "Fracasser":
 		return Sorts{
-			Nom:    "Fracasser",
-			Degats: 20,
-			Cout:   10,
+			Nom:         "Fracasser",
+			Degats:      20,
+			Cout:        10,
+			Element:     ElementPhysique,
+			Effet:       "Étourdissement",
+			ChanceEffet: 25,
 		}
 	case "Briser":
 		return Sorts{
-			Nom:    "Briser",
-			Degats: 40,
-			Cout:   20,
+			Nom:         "Briser",
+			Degats:      40,
+			Cout:        20,
+			Element:     ElementTerre,
+			Effet:       "Armure brisée",
+			ChanceEffet: 60,
 		}
 	default:
 		return Sorts{
-			Nom:    nom,
-			Degats: 25,
-			Cout:   15,
+			Nom:     nom,
+			Degats:  25,
+			Cout:    15,
+			Element: ElementPhysique,
 		}
 	}
 }
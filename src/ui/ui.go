@@ -155,21 +155,31 @@ func AfficherMenu(titre string, options []string) {
 	fmt.Println(ligneBordureInf)
 }
 
-func AfficherMenuCombat(joueurNom string, joueurPv, joueurPvMax, joueurMana, joueurManaMax int,
-	ennemiNom string, ennemiPv int, sortsList []sorts.Sorts, potions, potionsMana int) {
+func AfficherMenuCombat(joueurNom string, joueurPv, joueurPvMax, joueurMana, joueurManaMax int, joueurEffets string,
+	lignesEnnemis []string, sortsList []sorts.Sorts, attaqueBase string, degatsAttaqueBase, potions, potionsMana, objets, chanceFuite int) {
 
 	lignes := []string{}
 	lignes = append(lignes, fmt.Sprintf("%s : PV %d/%d | Mana %d/%d", joueurNom, joueurPv, joueurPvMax, joueurMana, joueurManaMax))
-	lignes = append(lignes, fmt.Sprintf("Ennemi %s : PV %d", ennemiNom, ennemiPv))
+	if joueurEffets != "" {
+		lignes = append(lignes, "  Effets : "+joueurEffets)
+	}
+	lignes = append(lignes, lignesEnnemis...)
 	lignes = append(lignes, "") // Ligne vide pour séparation
 
 	for i, s := range sortsList {
-		lignes = append(lignes, fmt.Sprintf("%d) %s - Dégâts: %d, Mana: %d", i+1, s.Nom, s.Degats, s.Cout))
+		portee := ""
+		if s.EstDeZone() {
+			portee = " (zone)"
+		}
+		lignes = append(lignes, fmt.Sprintf("%d) %s %s%s - %s, Dégâts: %d, Mana: %d", i+1, s.TypeDegats().Emoji(), s.Nom, portee, s.TypeDegats(), s.Degats, s.Cout))
 	}
 
-	lignes = append(lignes, fmt.Sprintf("%d) Utiliser une potion de vie (+50 PV) [%d disponibles]", len(sortsList)+1, potions))
-	lignes = append(lignes, fmt.Sprintf("%d) Utiliser une potion de mana (+50 Mana) [%d disponibles]", len(sortsList)+2, potionsMana))
-	lignes = append(lignes, fmt.Sprintf("%d) Fuir le combat", len(sortsList)+3))
+	lignes = append(lignes, fmt.Sprintf("%d) Attaque de base : %s - Physique, Dégâts: %d, sans mana", len(sortsList)+1, attaqueBase, degatsAttaqueBase))
+	lignes = append(lignes, fmt.Sprintf("%d) Se défendre - Dégâts reçus réduits, regagne un peu de mana", len(sortsList)+2))
+	lignes = append(lignes, fmt.Sprintf("%d) Utiliser une potion de vie (+50 PV) [%d disponibles]", len(sortsList)+3, potions))
+	lignes = append(lignes, fmt.Sprintf("%d) Utiliser une potion de mana (+50 Mana) [%d disponibles]", len(sortsList)+4, potionsMana))
+	lignes = append(lignes, fmt.Sprintf("%d) Utiliser un objet de combat [%d disponibles]", len(sortsList)+5, objets))
+	lignes = append(lignes, fmt.Sprintf("%d) Fuir le combat (%d%% de réussite)", len(sortsList)+6, chanceFuite))
 
 	// Calculer la largeur maximale
 	largeurContenu := 0
@@ -4,17 +4,98 @@ package world
 
 import (
 	"fmt"
+	"math/rand"
+	"strings"
 	"world_of_milousques/character"
+	"world_of_milousques/donjon"
 	"world_of_milousques/fight"
 	"world_of_milousques/item"
+	"world_of_milousques/meteo"
+	"world_of_milousques/region"
 )
 
+// Biome définit le type de terrain d'une zone (utilisé pour la météo)
+type Biome string
+
+const (
+	BiomeChamps  Biome = "Champs"
+	BiomeForet   Biome = "Forêt"
+	BiomeMine    Biome = "Mine"
+	BiomeRiviere Biome = "Rivière"
+	BiomeRoute   Biome = "Route"
+	BiomeVille   Biome = "Ville"
+)
+
+// TypeService définit les établissements qu'une zone peut accueillir
+type TypeService string
+
+const (
+	ServiceMarchand TypeService = "marchand"
+	ServiceForge    TypeService = "forge"
+	ServiceBanque   TypeService = "banque"
+	ServiceAuberge  TypeService = "auberge"
+	ServiceGuilde   TypeService = "guilde"
+)
+
+// Service représente un établissement d'une zone
+// Nom identifie le catalogue du marchand, les recettes de la forge, l'agence ou l'auberge
+type Service struct {
+	Type TypeService
+	Nom  string
+}
+
+// TypePointInteret définit la nature d'un point d'intérêt caché
+type TypePointInteret string
+
+const (
+	PointCoffre  TypePointInteret = "coffre"
+	PointPierre  TypePointInteret = "pierre"
+	PointPassage TypePointInteret = "passage"
+)
+
+// PointInteret représente un lieu caché qu'il faut fouiller une zone pour trouver
+type PointInteret struct {
+	Nom         string
+	Type        TypePointInteret
+	Description string   // Texte affiché à la découverte
+	Chance      int      // Probabilité de base (en %) de le trouver à chaque fouille
+	Or          int      // Coffre : or contenu
+	Objet       string   // Coffre : objet contenu ("" = aucun)
+	Experience  int      // Pierre de savoir : expérience gagnée à la lecture
+	Destination Position // Passage secret : zone d'arrivée
+}
+
 // PNJ représente un personnage non-joueur
 type PNJ struct {
 	Nom       string
 	Dialogue  string
 	Quete     string
 	Recompense string
+	// Emploi du temps du PNJ (vide = il ne quitte jamais sa zone)
+	Horaires []EtapeHoraire
+}
+
+// EtapeHoraire indique où se trouve un PNJ à partir d'une heure de la journée
+type EtapeHoraire struct {
+	Heure    int      // Heure de la journée (0 à 23) à laquelle le PNJ rejoint cette zone
+	Position Position
+	Dialogue string   // Remplace le dialogue habituel pendant cette étape ("" = inchangé)
+}
+
+// EtapeActuelle retourne l'étape de l'emploi du temps en cours à une heure de la journée
+// Avant la première étape de la journée, le PNJ est encore à la dernière étape de la veille
+func (p *PNJ) EtapeActuelle(heure int) (EtapeHoraire, bool) {
+	if len(p.Horaires) == 0 {
+		return EtapeHoraire{}, false
+	}
+	
+	etape := p.Horaires[len(p.Horaires)-1]
+	for _, e := range p.Horaires {
+		if e.Heure <= heure {
+			etape = e
+		}
+	}
+	return etape, true
 }
 
 // Zone représente une sous-zone de la map
@@ -25,6 +106,63 @@ type Zone struct {
 	Monstres    []fight.Ennemi
 	PNJs        []PNJ
 	Visitee     bool
+	Biome       Biome
+	// Règles de déplacement
+	CoutDeplacement int    // Heures nécessaires pour traverser la zone
+	ObjetRequis     string // Objet à posséder pour entrer ("" = aucun)
+	MessageBlocage  string // Explication affichée quand l'objet requis manque
+	// Conditions d'accès surveillées par un garde
+	NiveauRequis int    // Niveau minimum pour entrer (0 = aucun)
+	QueteRequise string // Quête à avoir accomplie pour entrer ("" = aucune)
+	Garde        string // Nom du garde qui bloque l'accès
+	MessageGarde string // Explication donnée par le garde
+	// Sous-lieu accessible depuis la zone (nil = aucun)
+	Donjon *donjon.Donjon
+	// Région secrète au-delà du bord de la carte (nil = aucune)
+	Region *region.Region
+	// Établissements de la zone (villes et villages)
+	Services []Service
+	// Événement du monde en cours dans la zone ("" = aucun)
+	Evenement string
+	// Des monstres reviennent occuper la zone tant que l'événement dure
+	Repeuplement bool
+	// Points d'intérêt cachés révélés par la fouille
+	PointsCaches []PointInteret
+	// Niveau de danger (0 = sûr, jusqu'à DangerMax) qui renforce les monstres et leur butin
+	Danger int
+}
+
+// DureeTraversee retourne le nombre d'heures pour traverser la zone (au moins 1)
+func (z *Zone) DureeTraversee() int {
+	if z.CoutDeplacement < 1 {
+		return 1
+	}
+	return z.CoutDeplacement
+}
+
+// DangerMax est le niveau de danger des zones les plus éloignées d'Astrab
+const DangerMax = 4
+
+// MultiplicateurDanger retourne le pourcentage appliqué aux statistiques des monstres de la zone
+// Le niveau 2 (les abords d'Astrab) sert de référence, chaque niveau ajoute ou retire 25%
+func (z *Zone) MultiplicateurDanger() int {
+	if z.Danger < 1 {
+		return 100
+	}
+	return 100 + 25*(z.Danger-2)
+}
+
+// ButinOr retourne l'or laissé par un monstre vaincu dans la zone
+func (z *Zone) ButinOr() int {
+	return 10 * z.Danger
+}
+
+// LibelleDanger retourne le niveau de danger de la zone sous forme d'étoiles
+func (z *Zone) LibelleDanger() string {
+	if z.Danger == 0 {
+		return "Zone sûre"
+	}
+	return fmt.Sprintf("Danger %s%s", strings.Repeat("★", z.Danger), strings.Repeat("☆", DangerMax-z.Danger))
 }
 
 // Position du joueur sur la map
@@ -32,16 +170,33 @@ type Position struct {
 	X, Y int
 }
 
+// PositionCapitale est la position d'Astrab, au centre de la map
+var PositionCapitale = Position{X: 2, Y: 2}
+
+// Rencontre représente un monstre qui surprend le joueur à son arrivée dans une zone
+type Rencontre struct {
+	Ennemi       fight.Ennemi
+	IndexMonstre int // Index dans Zone.Monstres, -1 pour un monstre errant
+}
+
 // Map représente la grille 5x5 du monde
 type Map struct {
 	Zones    [5][5]Zone
 	Position Position
+	Temps    int // Heure du jeu synchronisée avec le personnage (pour la météo)
+	
+	rencontre      *Rencontre       // Rencontre tirée lors du dernier déplacement
+	evenementRoute *EvenementRoute  // Événement de route tiré lors du dernier déplacement
+	evenements     []evenementActif // Événements du monde en cours
+	pnjsItinerants []PNJ            // PNJs qui suivent un emploi du temps
+	
+	horlogeSynchronisee bool // Temps a déjà reçu l'heure du personnage
 }
 
 // NewMap crée une nouvelle map avec des zones génériques
 func NewMap() *Map {
 	m := &Map{
-		Position: Position{X: 2, Y: 2}, // Position centrale au départ
+		Position: PositionCapitale, // Position centrale au départ
 	}
 	
 	// Initialiser toutes les zones avec du contenu générique
@@ -106,7 +261,7 @@ func (m *Map) restaurerAvecCharacter(char *character.Character) {
 				m.restaurerRessourcesZone(&m.Zones[y][x], etat.RessourcesRestantes)
 				
 				// Restaurer les monstres selon l'état sauvegardé
-				m.restaurerMonstresZone(&m.Zones[y][x], etat.MonstresRestants)
+				m.restaurerMonstresZone(&m.Zones[y][x], etat.MonstresRestants, char.TempsDeJeu)
 			}
 		}
 	}
@@ -123,19 +278,102 @@ func (m *Map) restaurerRessourcesZone(zone *Zone, ressourcesRestantes []string)
 }
 
 // restaurerMonstresZone restaure les monstres d'une zone selon l'état sauvegardé
-func (m *Map) restaurerMonstresZone(zone *Zone, monstresRestants []character.MonstreState) {
+// Les monstres blessés ont récupéré pendant le temps écoulé depuis la sauvegarde de leurs PV
+func (m *Map) restaurerMonstresZone(zone *Zone, monstresRestants []character.MonstreState, temps int) {
 	// Créer une nouvelle liste de monstres basée sur l'état sauvegardé
 	nouveauxMonstres := []fight.Ennemi{}
 	for _, monstreState := range monstresRestants {
-		nouveauxMonstres = append(nouveauxMonstres, fight.Ennemi{
+		monstre := fight.Ennemi{
 			Nom: monstreState.Nom,
 			Pv: monstreState.Pv,
 			Attaque: monstreState.Attaque,
-		})
+			PvMax: monstreState.PvMax,
+		}
+		monstre.Regenerer(temps - monstreState.Temps)
+		nouveauxMonstres = append(nouveauxMonstres, monstre)
 	}
 	zone.Monstres = nouveauxMonstres
 }
 
+// MettreAJourTemps synchronise l'horloge de la map avec celle du personnage
+// Les PNJs itinérants rejoignent la zone prévue par leur emploi du temps
+func (m *Map) MettreAJourTemps(temps int) {
+	if m.horlogeSynchronisee {
+		m.regenererMonstres(temps - m.Temps)
+	}
+	m.Temps = temps
+	m.horlogeSynchronisee = true
+	m.placerPNJsItinerants()
+}
+
+// regenererMonstres fait récupérer les monstres blessés de toutes les zones pendant les heures écoulées
+func (m *Map) regenererMonstres(heures int) {
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			for i := range m.Zones[y][x].Monstres {
+				m.Zones[y][x].Monstres[i].Regenerer(heures)
+			}
+		}
+	}
+}
+
+// repertorierPNJsItinerants retire des zones les PNJs qui ont un emploi du temps
+// pour que placerPNJsItinerants les déplace au fil de la journée
+func (m *Map) repertorierPNJsItinerants() {
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			zone := &m.Zones[y][x]
+			sedentaires := []PNJ{}
+			for _, pnj := range zone.PNJs {
+				if len(pnj.Horaires) > 0 {
+					m.pnjsItinerants = append(m.pnjsItinerants, pnj)
+				} else {
+					sedentaires = append(sedentaires, pnj)
+				}
+			}
+			zone.PNJs = sedentaires
+		}
+	}
+	m.placerPNJsItinerants()
+}
+
+// placerPNJsItinerants place chaque PNJ itinérant dans la zone de son étape actuelle
+func (m *Map) placerPNJsItinerants() {
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			zone := &m.Zones[y][x]
+			restants := []PNJ{}
+			for _, pnj := range zone.PNJs {
+				if len(pnj.Horaires) == 0 {
+					restants = append(restants, pnj)
+				}
+			}
+			zone.PNJs = restants
+		}
+	}
+	
+	for _, pnj := range m.pnjsItinerants {
+		etape, _ := pnj.EtapeActuelle(m.Temps % 24)
+		if etape.Dialogue != "" {
+			pnj.Dialogue = etape.Dialogue
+		}
+		zone := m.GetZoneAt(etape.Position.X, etape.Position.Y)
+		if zone != nil {
+			zone.PNJs = append(zone.PNJs, pnj)
+		}
+	}
+}
+
+// GetMeteoZone retourne la météo actuelle d'une zone selon sa région
+func (m *Map) GetMeteoZone(zone *Zone) meteo.Meteo {
+	return meteo.MeteoRegion(string(zone.Biome), m.Temps)
+}
+
+// DureeTrajet retourne le nombre d'heures pour rejoindre une zone : sa traversée, plus si sa météo est mauvaise
+func (m *Map) DureeTrajet(zone *Zone) int {
+	return zone.DureeTraversee() + m.GetMeteoZone(zone).CoutDeplacement
+}
+
 // GetCurrentZone retourne la zone actuelle du joueur
 func (m *Map) GetCurrentZone() *Zone {
 	return &m.Zones[m.Position.Y][m.Position.X]
@@ -150,8 +388,38 @@ func (m *Map) GetZoneAt(x, y int) *Zone {
 }
 
 // CanMoveTo vérifie si le joueur peut se déplacer vers une direction
+// Sans personnage, les zones qui demandent un équipement sont considérées bloquées
 func (m *Map) CanMoveTo(direction string) bool {
-	newX, newY := m.Position.X, m.Position.Y
+	return m.CanMoveToWithCharacter(direction, nil)
+}
+
+// CanMoveToWithCharacter vérifie si le personnage peut entrer dans la zone voisine
+func (m *Map) CanMoveToWithCharacter(direction string, character interface{}) bool {
+	return m.RaisonBlocage(direction, character) == ""
+}
+
+// RaisonBlocage explique pourquoi une direction est bloquée (chaîne vide si le passage est libre)
+func (m *Map) RaisonBlocage(direction string, character interface{}) string {
+	_, raison := m.voisin(m.Position, direction, character)
+	return raison
+}
+
+// GetZoneVoisine retourne la zone voisine dans une direction (nil au bord du monde)
+func (m *Map) GetZoneVoisine(direction string) *Zone {
+	arrivee, raison := m.voisin(m.Position, direction, nil)
+	if raison == raisonBordDuMonde {
+		return nil
+	}
+	return m.GetZoneAt(arrivee.X, arrivee.Y)
+}
+
+// raisonBordDuMonde est la raison de blocage renvoyée en dehors de la grille
+const raisonBordDuMonde = "Vous êtes au bord du monde"
+
+// voisin retourne la position atteinte depuis une position dans une direction
+// et la raison pour laquelle le personnage ne peut pas y entrer ("" si le passage est libre)
+func (m *Map) voisin(depart Position, direction string, character interface{}) (Position, string) {
+	newX, newY := depart.X, depart.Y
 	
 	switch direction {
 	case "NORD":
@@ -163,10 +431,91 @@ func (m *Map) CanMoveTo(direction string) bool {
 	case "EST":
 		newX++
 	default:
-		return false
+		return depart, "Direction inconnue"
+	}
+	
+	arrivee := Position{X: newX, Y: newY}
+	zone := m.GetZoneAt(newX, newY)
+	if zone == nil {
+		return arrivee, raisonBordDuMonde
+	}
+	
+	// Vérifier l'équipement requis par le terrain
+	if zone.ObjetRequis != "" {
+		possede := false
+		if char, ok := character.(interface{ PossedeObjet(string) bool }); ok {
+			possede = char.PossedeObjet(zone.ObjetRequis)
+		}
+		if !possede {
+			return arrivee, fmt.Sprintf("%s (objet requis : %s)", zone.MessageBlocage, zone.ObjetRequis)
+		}
+	}
+	
+	// Vérifier les conditions d'accès imposées par le garde
+	if zone.NiveauRequis > 0 {
+		niveau := 0
+		if char, ok := character.(interface{ ObtenirNiveau() int }); ok {
+			niveau = char.ObtenirNiveau()
+		}
+		if niveau < zone.NiveauRequis {
+			return arrivee, fmt.Sprintf("%s : « %s » (niveau %d requis)", zone.Garde, zone.MessageGarde, zone.NiveauRequis)
+		}
+	}
+	if zone.QueteRequise != "" {
+		accomplie := false
+		if char, ok := character.(interface{ QueteAccomplie(string) bool }); ok {
+			accomplie = char.QueteAccomplie(zone.QueteRequise)
+		}
+		if !accomplie {
+			return arrivee, fmt.Sprintf("%s : « %s » (quête requise : %s)", zone.Garde, zone.MessageGarde, zone.QueteRequise)
+		}
+	}
+	
+	return arrivee, ""
+}
+
+// CheminVers calcule la suite de directions la plus courte pour rejoindre une position
+// Seules les zones où le personnage peut entrer sont traversées
+// Retourne nil si la destination est inaccessible ou si le joueur y est déjà
+func (m *Map) CheminVers(x, y int, character interface{}) []string {
+	arrivee := Position{X: x, Y: y}
+	if arrivee == m.Position || m.GetZoneAt(x, y) == nil {
+		return nil
+	}
+	
+	// Parcours en largeur depuis la position actuelle du joueur
+	directions := []string{"NORD", "SUD", "OUEST", "EST"}
+	precedents := map[Position]Position{}
+	directionsPrises := map[Position]string{}
+	visitees := map[Position]bool{m.Position: true}
+	file := []Position{m.Position}
+	
+	for len(file) > 0 {
+		courante := file[0]
+		file = file[1:]
+		
+		for _, direction := range directions {
+			suivante, raison := m.voisin(courante, direction, character)
+			if raison != "" || visitees[suivante] {
+				continue
+			}
+			visitees[suivante] = true
+			precedents[suivante] = courante
+			directionsPrises[suivante] = direction
+			
+			if suivante == arrivee {
+				// Reconstruire le chemin à l'envers puis le retourner
+				chemin := []string{}
+				for p := arrivee; p != m.Position; p = precedents[p] {
+					chemin = append([]string{directionsPrises[p]}, chemin...)
+				}
+				return chemin
+			}
+			file = append(file, suivante)
+		}
 	}
 	
-	return newX >= 0 && newX < 5 && newY >= 0 && newY < 5
+	return nil
 }
 
 // MoveTo déplace le joueur dans une direction
@@ -176,7 +525,7 @@ func (m *Map) MoveTo(direction string) bool {
 
 // MoveToWithCharacter déplace le joueur et met à jour la sauvegarde du personnage
 func (m *Map) MoveToWithCharacter(direction string, character interface{}) bool {
-	if !m.CanMoveTo(direction) {
+	if !m.CanMoveToWithCharacter(direction, character) {
 		return false
 	}
 	
@@ -192,6 +541,26 @@ func (m *Map) MoveToWithCharacter(direction string, character interface{}) bool
 		m.Position.X++
 	}
 	
+	m.enregistrerArrivee(character)
+	m.tirerRencontre()
+	m.tirerEvenementRoute()
+	
+	return true
+}
+
+// EmprunterPassage transporte le joueur directement vers une zone par un passage secret
+func (m *Map) EmprunterPassage(destination Position, character interface{}) bool {
+	if destination.X < 0 || destination.X >= 5 || destination.Y < 0 || destination.Y >= 5 {
+		return false
+	}
+	
+	m.Position = destination
+	m.enregistrerArrivee(character)
+	return true
+}
+
+// enregistrerArrivee marque la zone actuelle comme visitée et met à jour la sauvegarde du personnage
+func (m *Map) enregistrerArrivee(character interface{}) {
 	m.GetCurrentZone().Visitee = true
 	
 	// Sauvegarder l'état si un personnage est fourni
@@ -202,49 +571,553 @@ func (m *Map) MoveToWithCharacter(direction string, character interface{}) bool
 		char.SauvegarderPositionMap(m.Position.X, m.Position.Y)
 		char.MarquerZoneDecouverte(m.Position.X, m.Position.Y)
 	}
+}
+
+// chanceRencontreBiome retourne la probabilité (en %) d'être surpris par un monstre en entrant dans un biome
+func chanceRencontreBiome(biome Biome) int {
+	switch biome {
+	case BiomeMine:
+		return 30
+	case BiomeForet:
+		return 25
+	case BiomeChamps, BiomeRiviere:
+		return 20
+	case BiomeRoute:
+		return 15
+	default: // Les villes sont sûres
+		return 0
+	}
+}
+
+// monstresErrants retourne les monstres qui rôdent dans un biome sans y habiter
+func monstresErrants(biome Biome) []fight.Ennemi {
+	switch biome {
+	case BiomeRoute:
+		return []fight.Ennemi{
+			{Nom: "Chacha Errant", Pv: 60, Attaque: 18},
+			{Nom: "Loup des chemins", Pv: 70, Attaque: 20},
+		}
+	default:
+		return []fight.Ennemi{}
+	}
+}
+
+// tirerRencontre détermine si un monstre surprend le joueur dans la zone actuelle
+// Les monstres de la zone sont prioritaires, sinon un monstre errant du biome peut apparaître
+func (m *Map) tirerRencontre() {
+	m.rencontre = nil
+	zone := m.GetCurrentZone()
 	
-	return true
+	if rand.Intn(100) >= chanceRencontreBiome(zone.Biome) {
+		return
+	}
+	
+	if len(zone.Monstres) > 0 {
+		index := rand.Intn(len(zone.Monstres))
+		m.rencontre = &Rencontre{Ennemi: zone.Monstres[index], IndexMonstre: index}
+		return
+	}
+	
+	errants := monstresErrants(zone.Biome)
+	if len(errants) > 0 {
+		errant := renforcerMonstre(errants[rand.Intn(len(errants))], zone.MultiplicateurDanger())
+		m.rencontre = &Rencontre{Ennemi: errant, IndexMonstre: -1}
+	}
+}
+
+// PrendreRencontre retourne la rencontre du dernier déplacement (nil si aucune) et l'efface
+func (m *Map) PrendreRencontre() *Rencontre {
+	rencontre := m.rencontre
+	m.rencontre = nil
+	return rencontre
+}
+
+// TypeEvenementRoute définit la nature d'un événement croisé sur une route
+type TypeEvenementRoute string
+
+const (
+	RouteMarchand TypeEvenementRoute = "marchand"
+	RouteBandits  TypeEvenementRoute = "bandits"
+	RouteVoyageur TypeEvenementRoute = "voyageur"
+)
+
+// chanceEvenementRoute est la probabilité (en %) de croiser quelqu'un en arrivant sur une route
+const chanceEvenementRoute = 35
+
+// EvenementRoute représente une rencontre non hostile (ou presque) sur une route
+type EvenementRoute struct {
+	Type        TypeEvenementRoute
+	Nom         string
+	Description string
+	Bandit      fight.Ennemi // Bandits : adversaire si le joueur refuse de payer
+	// Voyageur : petite quête à remplir sur place
+	ObjetDemande     string
+	QuantiteDemandee int
+	RecompenseOr     int
+}
+
+// voyageursEgares retourne les voyageurs qui peuvent demander de l'aide sur les routes
+func voyageursEgares() []EvenementRoute {
+	return []EvenementRoute{
+		{Type: RouteVoyageur, Nom: "Pèlerin égaré", Description: "Un pèlerin blessé s'est assis au bord de la route et vous demande de quoi se soigner.",
+			ObjetDemande: "Potion de Vie", QuantiteDemandee: 1, RecompenseOr: 80},
+		{Type: RouteVoyageur, Nom: "Charretier embourbé", Description: "Une charrette s'est enlisée dans une ornière. Son conducteur cherche des planches pour la dégager.",
+			ObjetDemande: "Bois", QuantiteDemandee: 5, RecompenseOr: 60},
+		{Type: RouteVoyageur, Nom: "Colporteuse affamée", Description: "Une colporteuse épuisée n'a rien mangé depuis deux jours.",
+			ObjetDemande: "Pichon", QuantiteDemandee: 3, RecompenseOr: 50},
+		{Type: RouteVoyageur, Nom: "Meunier pressé", Description: "Un meunier doit livrer sa farine à Astrab mais il lui manque du grain.",
+			ObjetDemande: "Blé", QuantiteDemandee: 3, RecompenseOr: 45},
+	}
+}
+
+// poidsEvenementsRoute retourne les chances relatives de chaque événement selon l'heure
+// Les marchands voyagent de jour, les bandits préfèrent la nuit
+func poidsEvenementsRoute(heure int) map[TypeEvenementRoute]int {
+	if heure >= 20 || heure < 6 {
+		return map[TypeEvenementRoute]int{RouteMarchand: 0, RouteBandits: 60, RouteVoyageur: 40}
+	}
+	return map[TypeEvenementRoute]int{RouteMarchand: 45, RouteBandits: 20, RouteVoyageur: 35}
+}
+
+// tirerEvenementRoute détermine si le joueur croise quelqu'un sur la route où il arrive
+// Aucun événement n'est tiré si un monstre a déjà surpris le joueur
+func (m *Map) tirerEvenementRoute() {
+	m.evenementRoute = nil
+	zone := m.GetCurrentZone()
+	
+	if zone.Biome != BiomeRoute || m.rencontre != nil || rand.Intn(100) >= chanceEvenementRoute {
+		return
+	}
+	
+	// Le tirage se fait avant que l'horloge n'avance : on se base sur l'heure d'arrivée
+	poids := poidsEvenementsRoute((m.Temps + m.DureeTrajet(zone)) % 24)
+	tirage := rand.Intn(poids[RouteMarchand] + poids[RouteBandits] + poids[RouteVoyageur])
+	
+	switch {
+	case tirage < poids[RouteMarchand]:
+		m.evenementRoute = &EvenementRoute{
+			Type:        RouteMarchand,
+			Nom:         "Colporteur Ambroise",
+			Description: "Une roulotte bariolée est arrêtée au bord du chemin. Son propriétaire vous fait de grands signes.",
+		}
+	case tirage < poids[RouteMarchand]+poids[RouteBandits]:
+		m.evenementRoute = &EvenementRoute{
+			Type:        RouteBandits,
+			Nom:         "Bandits de grand chemin",
+			Description: "Des silhouettes masquées vous barrent la route. \"La bourse ou la vie !\"",
+			Bandit:      renforcerMonstre(fight.Ennemi{Nom: "Bandit de grand chemin", Pv: 90, Attaque: 26}, zone.MultiplicateurDanger()),
+		}
+	default:
+		voyageurs := voyageursEgares()
+		voyageur := voyageurs[rand.Intn(len(voyageurs))]
+		m.evenementRoute = &voyageur
+	}
+}
+
+// PrendreEvenementRoute retourne l'événement de route du dernier déplacement (nil si aucun) et l'efface
+func (m *Map) PrendreEvenementRoute() *EvenementRoute {
+	evenement := m.evenementRoute
+	m.evenementRoute = nil
+	return evenement
+}
+
+// Evenement représente un événement du monde qui se propage de zone en zone
+// Il commence à une heure prévue ou quand le joueur rencontre un PNJ, et prend fin
+// quand assez d'envahisseurs ont été repoussés
+type Evenement struct {
+	Nom             string
+	Annonce         string // Message affiché au début de l'événement
+	DescriptionZone string // Décrit l'événement dans les zones touchées
+	Rumeur          string // Ce qu'en disent les habitants
+	MessageFin      string
+	// Déclenchement
+	DebutPrevu     int    // Heure de jeu du début automatique (0 = uniquement déclenché)
+	DeclencheurPNJ string // PNJ dont la rencontre déclenche l'événement ("" = aucun)
+	// Propagation
+	Origine               Position
+	IntervallePropagation int // Heures entre deux avancées vers les zones voisines
+	PorteeMax             int // Distance maximale atteinte depuis l'origine
+	Envahisseur           fight.Ennemi
+	EnvahisseursParZone   int
+	// Fin de l'événement
+	Objectif     int // Nombre d'envahisseurs à vaincre
+	RecompenseOr int
+}
+
+// getEvenements retourne tous les événements du monde
+func getEvenements() []Evenement {
+	return []Evenement{
+		{
+			Nom:             "Invasion Kairis",
+			Annonce:         "Les Kairis sortent en masse des mines et se répandent dans la région !",
+			DescriptionZone: "Des Kairis envahisseurs ont pris position ici et pillent tout ce qu'ils trouvent.",
+			Rumeur:          "Les Kairis débordent des mines... Il paraît qu'ils avancent un peu plus chaque demi-journée !",
+			MessageFin:      "Les Kairis, repoussés, se terrent de nouveau au fond des mines. La région respire !",
+			DebutPrevu:      56, // Jour 3, 8h
+			DeclencheurPNJ:  "Fillian",
+			// Position 2,2 -> index (1,1) : la mine de Fillian
+			Origine:               Position{X: 1, Y: 1},
+			IntervallePropagation: 12,
+			PorteeMax:             3,
+			Envahisseur:           fight.Ennemi{Nom: "Kairis Envahisseur", Pv: 100, Attaque: 32},
+			EnvahisseursParZone:   2,
+			Objectif:              10,
+			RecompenseOr:          250,
+		},
+	}
+}
+
+// evenementActif mémorise l'avancement d'un événement en cours pour l'affichage
+type evenementActif struct {
+	evenement Evenement
+	portee    int
+	vaincus   int
+}
+
+// porteeEvenement retourne la distance atteinte par un événement depuis son origine
+func porteeEvenement(e Evenement, debut, temps int) int {
+	portee := 0
+	if e.IntervallePropagation > 0 && temps > debut {
+		portee = (temps - debut) / e.IntervallePropagation
+	}
+	if portee > e.PorteeMax {
+		portee = e.PorteeMax
+	}
+	return portee
+}
+
+// distance retourne la distance en nombre de zones entre deux positions
+func distance(a, b Position) int {
+	dx, dy := a.X-b.X, a.Y-b.Y
+	if dx < 0 {
+		dx = -dx
+	}
+	if dy < 0 {
+		dy = -dy
+	}
+	return dx + dy
+}
+
+// MettreAJourEvenements démarre les événements prévus et applique les événements en cours aux zones
+// Les envahisseurs sont recalculés à chaque appel à partir de l'état du personnage,
+// ce qui évite de les dupliquer lors d'un rechargement de sauvegarde
+func (m *Map) MettreAJourEvenements(joueur *character.Character) {
+	evenements := getEvenements()
+	
+	// Retirer les effets précédents de toutes les zones
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			zone := &m.Zones[y][x]
+			zone.Evenement = ""
+			zone.Repeuplement = false
+			monstres := []fight.Ennemi{}
+			for _, monstre := range zone.Monstres {
+				envahisseur := false
+				for _, e := range evenements {
+					if monstre.Nom == e.Envahisseur.Nom {
+						envahisseur = true
+						break
+					}
+				}
+				if !envahisseur {
+					monstres = append(monstres, monstre)
+				}
+			}
+			zone.Monstres = monstres
+		}
+	}
+	
+	anciennesPortees := map[string]int{}
+	for _, actif := range m.evenements {
+		anciennesPortees[actif.evenement.Nom] = actif.portee
+	}
+	m.evenements = nil
+	
+	for _, e := range evenements {
+		etat, existe := joueur.ObtenirEtatEvenement(e.Nom)
+		if !existe && e.DebutPrevu > 0 && joueur.TempsDeJeu >= e.DebutPrevu {
+			m.demarrerEvenement(joueur, e, e.DebutPrevu)
+			etat, existe = joueur.ObtenirEtatEvenement(e.Nom)
+		}
+		if !existe || etat.Termine {
+			continue
+		}
+		
+		portee := porteeEvenement(e, etat.Debut, joueur.TempsDeJeu)
+		if anciennePortee, connu := anciennesPortees[e.Nom]; connu && portee > anciennePortee {
+			fmt.Printf("\n⚠️  %s : l'événement s'étend à de nouvelles zones !\n", e.Nom)
+		}
+		m.evenements = append(m.evenements, evenementActif{evenement: e, portee: portee, vaincus: etat.TotalVaincus()})
+		
+		for y := 0; y < 5; y++ {
+			for x := 0; x < 5; x++ {
+				zone := &m.Zones[y][x]
+				if zone.Biome == BiomeVille || distance(e.Origine, Position{X: x, Y: y}) > portee {
+					continue
+				}
+				zone.Evenement = e.DescriptionZone
+				for i := etat.VaincusDansZone(x, y); i < e.EnvahisseursParZone; i++ {
+					zone.Monstres = append(zone.Monstres, renforcerMonstre(e.Envahisseur, zone.MultiplicateurDanger()))
+					zone.Repeuplement = true
+				}
+			}
+		}
+	}
+}
+
+// demarrerEvenement enregistre le début d'un événement et l'annonce au joueur
+func (m *Map) demarrerEvenement(joueur *character.Character, e Evenement, debut int) {
+	joueur.DemarrerEvenement(e.Nom, debut)
+	fmt.Printf("\n📯 === %s === 📯\n", strings.ToUpper(e.Nom))
+	fmt.Println(e.Annonce)
+	fmt.Printf("🎯 Objectif : repousser %d envahisseurs (%s)\n", e.Objectif, e.Envahisseur.Nom)
+}
+
+// DeclencherEvenementsPNJ démarre les événements liés à un PNJ quand le joueur lui parle
+func (m *Map) DeclencherEvenementsPNJ(joueur *character.Character, nomPNJ string) {
+	for _, e := range getEvenements() {
+		if e.DeclencheurPNJ != nomPNJ {
+			continue
+		}
+		if _, existe := joueur.ObtenirEtatEvenement(e.Nom); !existe {
+			m.demarrerEvenement(joueur, e, joueur.TempsDeJeu)
+			m.MettreAJourEvenements(joueur)
+		}
+	}
+}
+
+// SignalerVictoire compte un monstre vaincu dans la zone actuelle pour les événements en cours
+// et termine les événements dont l'objectif est atteint
+func (m *Map) SignalerVictoire(joueur *character.Character, nomMonstre string) {
+	for _, actif := range m.evenements {
+		e := actif.evenement
+		if e.Envahisseur.Nom != nomMonstre {
+			continue
+		}
+		
+		total := joueur.EnregistrerVictoireEvenement(e.Nom, m.Position.X, m.Position.Y)
+		if total < e.Objectif {
+			fmt.Printf("📯 %s : %d/%d envahisseurs repoussés\n", e.Nom, total, e.Objectif)
+			continue
+		}
+		
+		joueur.TerminerEvenement(e.Nom)
+		joueur.Argent += e.RecompenseOr
+		fmt.Printf("\n🎉 %s\n", e.MessageFin)
+		fmt.Printf("💰 Les habitants vous remercient avec %d pièces d'or !\n", e.RecompenseOr)
+	}
+	m.MettreAJourEvenements(joueur)
+}
+
+// RumeursEvenements retourne ce que les habitants disent des événements en cours
+func (m *Map) RumeursEvenements() []string {
+	rumeurs := []string{}
+	for _, actif := range m.evenements {
+		rumeurs = append(rumeurs, actif.evenement.Rumeur)
+	}
+	return rumeurs
+}
+
+// OptionsAffichage contrôle le rendu de la carte dans le terminal
+type OptionsAffichage struct {
+	Couleurs bool // Colorer les biomes avec les codes ANSI
+	ASCII    bool // Remplacer les emojis par des caractères ASCII
+}
+
+// Affichage est la configuration de rendu utilisée par AfficherMap (modifiable au lancement)
+var Affichage = OptionsAffichage{Couleurs: true}
+
+// Codes ANSI utilisés pour colorer la carte
+const (
+	ansiReset  = "\033[0m"
+	ansiJoueur = "\033[1;31m"
+)
+
+// largeurCellule est la largeur d'affichage de l'intérieur d'une case de la carte
+const largeurCellule = 7
+
+// glypheBiome retourne le symbole d'un biome, sa largeur d'affichage et sa couleur ANSI
+func glypheBiome(biome Biome) (string, int, string) {
+	emoji, ascii, couleur := "○", "o", "\033[37m"
+	switch biome {
+	case BiomeChamps:
+		emoji, ascii, couleur = "🌾", "C", "\033[33m"
+	case BiomeForet:
+		emoji, ascii, couleur = "🌲", "F", "\033[32m"
+	case BiomeMine:
+		emoji, ascii, couleur = "🗻", "M", "\033[35m"
+	case BiomeRiviere:
+		emoji, ascii, couleur = "🌊", "R", "\033[36m"
+	case BiomeRoute:
+		emoji, ascii, couleur = "🟫", "=", "\033[37m"
+	case BiomeVille:
+		emoji, ascii, couleur = "🏰", "A", "\033[1;33m"
+	}
+	
+	if Affichage.ASCII {
+		return ascii, 1, couleur
+	}
+	return emoji, 2, couleur
+}
+
+// symboleJoueur retourne le marqueur de la position du joueur
+func symboleJoueur() string {
+	if Affichage.ASCII {
+		return "@"
+	}
+	return "♦"
+}
+
+// marqueursZone retourne les marqueurs (un caractère chacun) des points d'intérêt d'une zone
+func marqueursZone(zone *Zone) string {
+	marqueurs := ""
+	
+	// PNJ qui propose une quête
+	for _, pnj := range zone.PNJs {
+		if pnj.Quete != "" {
+			marqueurs += "!"
+			break
+		}
+	}
+	
+	// Boutiques et établissements
+	if len(zone.Services) > 0 {
+		marqueurs += "$"
+	}
+	
+	// Événement du monde en cours
+	if zone.Evenement != "" {
+		marqueurs += "*"
+	}
+	
+	// Zone dont les monstres reviennent
+	if zone.Repeuplement {
+		marqueurs += "+"
+	}
+	
+	// Zone dont les monstres et les ressources ont été épuisés
+	if zone.Biome != BiomeVille && zone.Biome != BiomeRoute && len(zone.Monstres) == 0 && len(zone.Ressources) == 0 {
+		marqueurs += "x"
+	}
+	
+	return marqueurs
+}
+
+// celluleCarte construit l'intérieur d'une case de la carte, centré sur largeurCellule colonnes
+func (m *Map) celluleCarte(x, y int) string {
+	zone := &m.Zones[y][x]
+	estJoueur := x == m.Position.X && y == m.Position.Y
+	
+	// Les zones inconnues ne révèlent ni leur biome ni leurs marqueurs
+	if !zone.Visitee && !estJoueur {
+		return strings.Repeat(" ", 3) + "?" + strings.Repeat(" ", 3)
+	}
+	
+	glyphe, largeur, couleur := glypheBiome(zone.Biome)
+	marqueurs := marqueursZone(zone)
+	largeur += len(marqueurs)
+	
+	joueur := ""
+	if estJoueur {
+		joueur = symboleJoueur()
+		largeur++
+	}
+	
+	if Affichage.Couleurs {
+		glyphe = couleur + glyphe + marqueurs + ansiReset
+		if estJoueur {
+			joueur = ansiJoueur + joueur + ansiReset
+		}
+	} else {
+		glyphe += marqueurs
+	}
+	
+	gauche := (largeurCellule - largeur) / 2
+	droite := largeurCellule - largeur - gauche
+	return strings.Repeat(" ", gauche) + joueur + glyphe + strings.Repeat(" ", droite)
 }
 
-// AfficherMap affiche la map ASCII avec la position du joueur
+// AfficherMap affiche la map avec les biomes visités, les points d'intérêt et la position du joueur
 func (m *Map) AfficherMap() {
 	fmt.Println("\n=== CARTE DU MONDE ===")
 	fmt.Println()
 	
+	ligneSeparation := strings.Repeat("+"+strings.Repeat("-", largeurCellule), 5) + "+"
+	
 	for y := 0; y < 5; y++ {
 		// Ligne du haut de chaque rangée
-		for x := 0; x < 5; x++ {
-			fmt.Print("+-------")
-		}
-		fmt.Println("+")
+		fmt.Println(ligneSeparation)
 		
 		// Ligne du milieu avec le contenu
 		for x := 0; x < 5; x++ {
-			zone := &m.Zones[y][x]
-			symbol := " "
-			
-			if x == m.Position.X && y == m.Position.Y {
-				symbol = "♦" // Position du joueur
-			} else if zone.Visitee {
-				symbol = "○" // Zone visitée
-			} else {
-				symbol = "?" // Zone inconnue
-			}
-			
-			fmt.Printf("|   %s   ", symbol)
+			fmt.Print("|" + m.celluleCarte(x, y))
 		}
 		fmt.Println("|")
 	}
 	
 	// Ligne du bas
-	for x := 0; x < 5; x++ {
-		fmt.Print("+-------")
+	fmt.Println(ligneSeparation)
+	
+	m.afficherLegende()
+	
+	meteoActuelle := m.GetMeteoZone(m.GetCurrentZone())
+	libelleMeteo := meteoActuelle.Libelle()
+	if Affichage.ASCII {
+		libelleMeteo = meteoActuelle.Nom
+	}
+	fmt.Printf("Position actuelle: %s (%d,%d) | %s\n", 
+		m.GetCurrentZone().Nom, m.Position.X+1, m.Position.Y+1, m.GetCurrentZone().LibelleDanger())
+	fmt.Printf("Jour %d, %02dh | Météo: %s\n",
+		m.Temps/24+1, m.Temps%24, libelleMeteo)
+	
+	for _, actif := range m.evenements {
+		fmt.Printf("📯 %s en cours : %d/%d envahisseurs repoussés\n",
+			actif.evenement.Nom, actif.vaincus, actif.evenement.Objectif)
+	}
+}
+
+// afficherLegende affiche la légende des biomes et des marqueurs de la carte
+func (m *Map) afficherLegende() {
+	biomes := []struct {
+		biome Biome
+		nom   string
+	}{
+		{BiomeVille, "Ville"}, {BiomeRoute, "Route"}, {BiomeChamps, "Champs"},
+		{BiomeForet, "Forêt"}, {BiomeMine, "Mine"}, {BiomeRiviere, "Rivière"},
 	}
-	fmt.Println("+")
 	
-	fmt.Println("\nLégende: ♦ = Vous | ○ = Visitée | ? = Inconnue")
-	fmt.Printf("Position actuelle: %s (%d,%d)\n", 
-		m.GetCurrentZone().Nom, m.Position.X+1, m.Position.Y+1)
+	legende := []string{}
+	for _, b := range biomes {
+		glyphe, _, couleur := glypheBiome(b.biome)
+		if Affichage.Couleurs {
+			glyphe = couleur + glyphe + ansiReset
+		}
+		legende = append(legende, fmt.Sprintf("%s %s", glyphe, b.nom))
+	}
+	
+	fmt.Printf("\nLégende: %s = Vous | ? = Inconnue | %s\n", symboleJoueur(), strings.Join(legende, " | "))
+	fmt.Println("Marqueurs: ! = Quête disponible | $ = Boutiques | * = Événement en cours | + = Monstres qui reviennent | x = Zone vidée")
+}
+
+// AfficherDirections affiche les directions praticables depuis la position du joueur
+// et explique pourquoi les autres sont bloquées
+func (m *Map) AfficherDirections(character interface{}) {
+	directions := []struct{ code, nom string }{
+		{"NORD", "Nord"}, {"SUD", "Sud"}, {"OUEST", "Ouest"}, {"EST", "Est"},
+	}
+	
+	fmt.Println("🧭 Directions :")
+	for _, d := range directions {
+		zone := m.GetZoneVoisine(d.code)
+		if zone == nil {
+			continue
+		}
+		if raison := m.RaisonBlocage(d.code, character); raison != "" {
+			fmt.Printf("   %-5s : ⛔ %s\n", d.nom, raison)
+		} else {
+			fmt.Printf("   %-5s : ✅ %dh de trajet | %s\n", d.nom, zone.DureeTraversee(), zone.LibelleDanger())
+		}
+	}
 }
 
 // initializeZones remplit la map avec du contenu géographiquement cohérent
@@ -293,6 +1166,7 @@ func (m *Map) initializeZones() {
 			if isRoute {
 				// Configuration spéciale pour les routes
 				zone.Nom = "Route"
+				zone.Biome = BiomeRoute
 				zone.Description = "A la croisée des chemins, on trouve tous les gros malins !"
 				zone.Ressources = []item.Item{} // Aucune ressource
 				zone.Monstres = []fight.Ennemi{} // Aucun monstre
@@ -301,6 +1175,7 @@ func (m *Map) initializeZones() {
 			} else if isSpecialChamps {
 				// Configuration spéciale pour les nouveaux champs
 				zone.Nom = "Champs"
+				zone.Biome = BiomeChamps
 				zone.Description = "Le territoire de Mylène"
 				m.setupSpecialChampsZone(zone)
 				zone.Visitee = false
@@ -309,6 +1184,7 @@ func (m *Map) initializeZones() {
 			} else if isSpecialMine {
 				// Configuration spéciale pour les nouvelles mines
 				zone.Nom = "Mine"
+				zone.Biome = BiomeMine
 				zone.Description = "Depuis les attaques de Kairis, les mineurs ont déserter l'endroit"
 				m.setupSpecialMineZone(zone)
 				zone.Visitee = false
@@ -317,6 +1193,7 @@ func (m *Map) initializeZones() {
 			} else if isSpecialForet {
 				// Configuration spéciale pour les nouvelles forêts
 				zone.Nom = "Forêt"
+				zone.Biome = BiomeForet
 				zone.Description = "Construire un parking pour lutter contre la forestation"
 				m.setupSpecialForetZone(zone)
 				zone.Visitee = false
@@ -325,6 +1202,7 @@ func (m *Map) initializeZones() {
 			} else if isSpecialRiviere {
 				// Configuration spéciale pour les nouvelles rivières
 				zone.Nom = "Rivière"
+				zone.Biome = BiomeRiviere
 				zone.Description = "On aurais préférer 3 rivières"
 				m.setupSpecialRiviereZone(zone)
 				zone.Visitee = false
@@ -336,6 +1214,8 @@ func (m *Map) initializeZones() {
 				
 				// Astrab a une configuration spéciale
 				if zoneType == "Astrab" {
+					zone.Nom = "Astrab"
+					zone.Biome = BiomeVille
 					zone.Description = "Astrab, la magnifique capitale du royaume. Ses rues pavées fourmillent de marchands, d'artisans et d'aventuriers. Au cœur de la cité se dressent la Grande Forge, le Marché Central et la Banque Royale."
 					zone.Visitee = true
 					// Astrab n'a pas de monstres ni de ressources mais des PNJs spéciaux
@@ -346,6 +1226,14 @@ func (m *Map) initializeZones() {
 						{Nom: "Maître Forgeron Hassan", Dialogue: "Ma forge est à votre disposition pour créer de merveilleux objets !", Quete: "", Recompense: ""},
 						{Nom: "Banquier Salomon", Dialogue: "La Banque Royale garde vos biens précieux en sécurité !", Quete: "", Recompense: ""},
 						{Nom: "Garde Royale", Dialogue: "Astrab est la cité la plus sûre du royaume, aventurier.", Quete: "", Recompense: ""},
+						{Nom: "Capitaine Ombeline", Dialogue: "Besoin de lames pour couvrir vos arrières ? Passez à la guilde.", Quete: "", Recompense: ""},
+					}
+					zone.Services = []Service{
+						{Type: ServiceForge, Nom: "Grande Forge d'Astrab"},
+						{Type: ServiceMarchand, Nom: "Maître Karim le Marchand"},
+						{Type: ServiceBanque, Nom: "Banque Royale d'Astrab"},
+						{Type: ServiceAuberge, Nom: "Auberge du Chacha Ronronnant"},
+						{Type: ServiceGuilde, Nom: "Guilde des Lames d'Astrab"},
 					}
 				} else {
 				// Générer le contenu selon le type de zone
@@ -357,20 +1245,209 @@ func (m *Map) initializeZones() {
 			}
 		}
 	}
+	
+	m.ajouterVillages()
+	m.appliquerDanger()
+	m.appliquerReglesTerrain()
+	m.ajouterDonjons()
+	m.ajouterRegionsSecretes()
+	m.ajouterPointsInteret()
+	m.repertorierPNJsItinerants()
+}
+
+// ajouterPointsInteret cache des coffres, pierres de savoir et passages secrets dans certaines zones
+func (m *Map) ajouterPointsInteret() {
+	// Position 4,1 -> index (3,0) : un coffre enterré au bord d'un champ
+	m.Zones[0][3].PointsCaches = []PointInteret{
+		{Nom: "Coffre enterré", Type: PointCoffre, Chance: 35, Or: 80, Objet: "Potion de Vie",
+			Description: "Sous un épouvantail, la terre sonne creux : un vieux coffre de fermier y est enterré !"},
+	}
+	
+	// Position 2,2 -> index (1,1) : les mineurs ont gravé leur histoire dans la roche
+	m.Zones[1][1].PointsCaches = []PointInteret{
+		{Nom: "Pierre gravée des mineurs", Type: PointPierre, Chance: 40, Experience: 40,
+			Description: "\"Ici, les mineurs d'Astrab ont creusé jusqu'au cœur de la montagne. Les Kairis sont venus d'en dessous.\""},
+	}
+	
+	// Position 1,4 -> index (0,3) et 4,5 -> index (3,4) : le souterrain des contrebandiers relie la forêt à la rivière
+	m.Zones[3][0].PointsCaches = []PointInteret{
+		{Nom: "Souterrain des contrebandiers", Type: PointPassage, Chance: 25, Destination: Position{X: 3, Y: 4},
+			Description: "Derrière un rideau de lierre, une trappe s'ouvre sur un souterrain qui file vers l'est."},
+	}
+	m.Zones[4][3].PointsCaches = []PointInteret{
+		{Nom: "Souterrain des contrebandiers", Type: PointPassage, Chance: 25, Destination: Position{X: 0, Y: 3},
+			Description: "Sous une barque retournée sur la berge, un tunnel s'enfonce vers l'ouest."},
+		{Nom: "Cache de contrebande", Type: PointCoffre, Chance: 20, Or: 150,
+			Description: "Une caisse scellée flotte entre les roseaux, pleine de pièces d'or."},
+	}
+	
+	// Position 1,5 -> index (0,4) : une pierre runique oubliée au fond des bois
+	m.Zones[4][0].PointsCaches = []PointInteret{
+		{Nom: "Pierre runique", Type: PointPierre, Chance: 30, Experience: 60,
+			Description: "\"Les Milousques ont bâti Astrab au croisement des quatre terres, là où aucune ne domine les autres.\""},
+	}
+	
+	// Position 3,5 -> index (2,4) : une borne ancienne au bord de la route
+	m.Zones[4][2].PointsCaches = []PointInteret{
+		{Nom: "Borne ancienne", Type: PointPierre, Chance: 50, Experience: 20,
+			Description: "\"Astrab : 2 lieues. Port-Pichon : par la rivière. Les mines : à vos risques et périls.\""},
+	}
+}
+
+// ajouterVillages installe les villages secondaires et leurs établissements
+func (m *Map) ajouterVillages() {
+	// Position 5,3 -> index (4,2) : un village de pêcheurs au bord de la rivière
+	village := &m.Zones[2][4]
+	village.Nom = "Port-Pichon"
+	village.Biome = BiomeVille
+	village.Description = "Un village de pêcheurs bâti sur pilotis au bord de la rivière. Les filets sèchent au soleil et l'odeur du pichon grillé flotte entre les cabanes."
+	village.Ressources = []item.Item{}
+	village.Monstres = []fight.Ennemi{}
+	village.PNJs = []PNJ{
+		{Nom: "Marchande Perle", Dialogue: "Tout ce qu'il faut pour la pêche et la navigation, à prix d'ami !", Quete: "", Recompense: ""},
+		{Nom: "Charpentière Ondine", Dialogue: "Mon atelier construit les meilleures barques de la rivière.", Quete: "", Recompense: ""},
+		{Nom: "Vieux Loïc", Dialogue: "Au sud, les eaux deviennent profondes. Ne t'y risque pas sans barque !", Quete: "", Recompense: ""},
+	}
+	village.Services = []Service{
+		{Type: ServiceForge, Nom: "Atelier du Port"},
+		{Type: ServiceMarchand, Nom: "Marchande Perle"},
+		{Type: ServiceBanque, Nom: "Comptoir de Port-Pichon"},
+		{Type: ServiceAuberge, Nom: "Auberge du Pichon Doré"},
+	}
+}
+
+// appliquerDanger calcule le niveau de danger de chaque zone selon sa distance à Astrab
+// et renforce ses monstres en conséquence
+func (m *Map) appliquerDanger() {
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			zone := &m.Zones[y][x]
+			
+			switch zone.Biome {
+			case BiomeVille:
+				zone.Danger = 0
+			case BiomeRoute:
+				// Les routes sont patrouillées : un niveau de moins que les terres voisines
+				zone.Danger = distance(PositionCapitale, Position{X: x, Y: y}) - 1
+			default:
+				zone.Danger = distance(PositionCapitale, Position{X: x, Y: y})
+			}
+			if zone.Biome != BiomeVille && zone.Danger < 1 {
+				zone.Danger = 1
+			}
+			if zone.Danger > DangerMax {
+				zone.Danger = DangerMax
+			}
+			
+			multiplicateur := zone.MultiplicateurDanger()
+			for i := range zone.Monstres {
+				zone.Monstres[i] = renforcerMonstre(zone.Monstres[i], multiplicateur)
+			}
+		}
+	}
+}
+
+// renforcerMonstre applique un pourcentage aux PV et à l'attaque d'un monstre
+func renforcerMonstre(monstre fight.Ennemi, multiplicateur int) fight.Ennemi {
+	monstre.Pv = monstre.Pv * multiplicateur / 100
+	monstre.Attaque = monstre.Attaque * multiplicateur / 100
+	return monstre
+}
+
+// ajouterDonjons place les entrées des donjons sous les mines
+func (m *Map) ajouterDonjons() {
+	// Position 1,1 -> index (0,0) : un puits au fond de la mine profonde
+	m.Zones[0][0].Donjon = donjon.GetDonjon("Profondeurs Kairis")
+	
+	// Position 2,1 -> index (1,0) : les anciennes galeries des mineurs
+	m.Zones[0][1].Donjon = donjon.GetDonjon("Galeries effondrées")
+}
+
+// ajouterRegionsSecretes rattache les régions secrètes aux zones du bord de la carte
+func (m *Map) ajouterRegionsSecretes() {
+	// Position 1,5 -> index (0,4) : au sud-ouest, la forêt se perd dans la brume
+	m.Zones[4][0].Region = region.GetRegion("Sentier des Brumes")
+	
+	// Position 5,5 -> index (4,4) : au bout de la rivière profonde, une île inconnue
+	m.Zones[4][4].Region = region.GetRegion("Île Milousque")
+}
+
+// coutDeplacementBiome retourne le nombre d'heures pour traverser un biome
+func coutDeplacementBiome(biome Biome) int {
+	switch biome {
+	case BiomeForet, BiomeMine, BiomeRiviere:
+		return 2
+	default: // Routes, champs et villes
+		return 1
+	}
+}
+
+// appliquerReglesTerrain définit le coût de déplacement des zones et les équipements requis
+func (m *Map) appliquerReglesTerrain() {
+	for y := 0; y < 5; y++ {
+		for x := 0; x < 5; x++ {
+			m.Zones[y][x].CoutDeplacement = coutDeplacementBiome(m.Zones[y][x].Biome)
+		}
+	}
+	
+	// Position 5,5 -> index (4,4) : les eaux profondes ne se traversent qu'en barque
+	riviereProfonde := &m.Zones[4][4]
+	riviereProfonde.Nom = "Rivière profonde"
+	riviereProfonde.CoutDeplacement = 3
+	riviereProfonde.ObjetRequis = "Barque"
+	riviereProfonde.MessageBlocage = "Les eaux sont trop profondes pour y nager"
+	
+	// Position 1,1 -> index (0,0) : le fond de la mine est plongé dans le noir
+	mineProfonde := &m.Zones[0][0]
+	mineProfonde.Nom = "Mine profonde"
+	mineProfonde.CoutDeplacement = 3
+	mineProfonde.ObjetRequis = "Lanterne"
+	mineProfonde.MessageBlocage = "Les galeries sont plongées dans l'obscurité"
+	
+	m.appliquerAccesGardes()
+}
+
+// appliquerAccesGardes place des gardes à l'entrée des régions dangereuses
+func (m *Map) appliquerAccesGardes() {
+	// Les mines des Kairis : positions 2,1 / 1,2 / 2,2 -> index (1,0) / (0,1) / (1,1)
+	for _, p := range []Position{{X: 1, Y: 0}, {X: 0, Y: 1}, {X: 1, Y: 1}} {
+		zone := &m.Zones[p.Y][p.X]
+		zone.NiveauRequis = 3
+		zone.Garde = "Sentinelle des mines"
+		zone.MessageGarde = "Les Kairis déchiquettent les novices, revenez quand vous serez plus aguerri"
+	}
+	
+	// Position 1,1 -> index (0,0) : le fond de la mine est fermé tant que les Kairis n'ont pas été repoussés
+	mineProfonde := &m.Zones[0][0]
+	mineProfonde.NiveauRequis = 4
+	mineProfonde.QueteRequise = "Répression des Kairis"
+	mineProfonde.Garde = "Capitaine de la garde"
+	mineProfonde.MessageGarde = "Personne ne descend au fond tant que Fillian n'a pas été aidé"
+	
+	// Position 5,1 -> index (4,0) : les champs reculés de l'est sont infestés
+	champsRecules := &m.Zones[0][4]
+	champsRecules.NiveauRequis = 2
+	champsRecules.Garde = "Fermier inquiet"
+	champsRecules.MessageGarde = "Les moutmouts sont enragés par là-bas, prenez un peu d'expérience d'abord"
 }
 
 // setupZoneByType configure une zone selon son type géographique
 func (m *Map) setupZoneByType(zone *Zone, zoneType string) {
 	switch zoneType {
 	case "Champs":
+		zone.Biome = BiomeChamps
 		m.setupChampsZone(zone)
 	case "Forêt":
+		zone.Biome = BiomeForet
 		m.setupForetZone(zone)
 	case "Mines":
+		zone.Biome = BiomeMine
 		m.setupMinesZone(zone)
 	case "Rivière":
+		zone.Biome = BiomeRiviere
 		m.setupRiviereZone(zone)
 	default: // Zones de transition
+		zone.Biome = BiomeRoute
 		m.setupTransitionZone(zone, zoneType)
 	}
 }
@@ -654,6 +1731,11 @@ func (m *Map) ajouterPNJsSpeciaux(zone *Zone, x int, y int) {
 			Dialogue: "Shaaaark ! La danse des crabe hijacob est insupportable",
 			Quete: "Nettoyage des Rivières",
 			Recompense: "300 or, 3 potions de vie, 3 potions de mana",
+			// Pêche le jour, dort à Port-Pichon la nuit
+			Horaires: []EtapeHoraire{
+				{Heure: 6, Position: Position{X: 3, Y: 3}},
+				{Heure: 19, Position: Position{X: 4, Y: 2}, Dialogue: "Shaaaark... Pas de crabes la nuit, juste un bon pichon grillé !"},
+			},
 		}
 		zone.PNJs = append(zone.PNJs, pnjGura)
 	}
@@ -665,6 +1747,12 @@ func (m *Map) ajouterPNJsSpeciaux(zone *Zone, x int, y int) {
 			Dialogue: "Les champs sont envahi, la BRUV N est dépasser ! Va apporter la démocratie",
 			Quete: "Raid des Champs",
 			Recompense: "300 or, 3 potions de vie, 3 potions de mana",
+			// Patrouille les champs puis rentre à Astrab le soir
+			Horaires: []EtapeHoraire{
+				{Heure: 7, Position: Position{X: 3, Y: 1}},
+				{Heure: 13, Position: Position{X: 4, Y: 1}, Dialogue: "Je patrouille les champs de l'est ! La BRUV N ne passera pas !"},
+				{Heure: 20, Position: Position{X: 2, Y: 2}, Dialogue: "Ahoy ! Même une capitaine a besoin de repos en ville."},
+			},
 		}
 		zone.PNJs = append(zone.PNJs, pnjMarine)
 	}
@@ -676,6 +1764,11 @@ func (m *Map) ajouterPNJsSpeciaux(zone *Zone, x int, y int) {
 			Dialogue: "Ces Kairis ont envahi mes mines ! Ils détournent les mineurs ! Il faut les stopper de toute urgence !",
 			Quete: "Répression des Kairis",
 			Recompense: "300 or, 3 potions de vie, 3 potions de mana",
+			// Surveille sa mine le jour, se réfugie à Astrab la nuit
+			Horaires: []EtapeHoraire{
+				{Heure: 8, Position: Position{X: 1, Y: 1}},
+				{Heure: 18, Position: Position{X: 2, Y: 2}, Dialogue: "La nuit, les Kairis sont trop nombreux... Je garde un œil sur la mine depuis la ville."},
+			},
 		}
 		zone.PNJs = append(zone.PNJs, pnjFillian)
 	}
@@ -687,6 +1780,12 @@ func (m *Map) ajouterPNJsSpeciaux(zone *Zone, x int, y int) {
 			Dialogue: "J'en peut plus des ecumouilles, va faire un petit massacre pitié !",
 			Quete: "Nettoyage de Forêt",
 			Recompense: "300 or, 3 potions de vie, 3 potions de mana",
+			// Fait le tour de ses coins de cueillette dans la forêt
+			Horaires: []EtapeHoraire{
+				{Heure: 6, Position: Position{X: 1, Y: 3}},
+				{Heure: 14, Position: Position{X: 0, Y: 3}, Dialogue: "Je cueille des champignons, mais les écumouilles me suivent partout !"},
+				{Heure: 22, Position: Position{X: 1, Y: 3}},
+			},
 		}
 		zone.PNJs = append(zone.PNJs, pnjShxtou)
 	}